@@ -0,0 +1,128 @@
+package main
+
+import (
+	"cmp"
+	"go/types"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+)
+
+// getImportAliases returns the aliases (by import path) of the imports whose package name clashes
+// with the name of another import or with an identifier of the package scope.
+// The imports required by the template (testing, time, fmt, mock, ...) are never aliased, except testify mock by the testify alias option,
+// then the standard library imports have priority over the others.
+func getImportAliases(pkgDesc PackageDesc, opts Options, required []string) map[string]string {
+	// The names of the imports required by the template.
+	taken := map[string]struct{}{opts.testifyAlias(): {}}
+	for _, importPath := range required {
+		if importPath != testifyMockPkg {
+			taken[path.Base(importPath)] = struct{}{}
+		}
+	}
+
+	names := getPackageNames(pkgDesc)
+
+	var paths []string
+	for importPath := range names {
+		if importPath == testifyMockPkg || slices.Contains(required, importPath) {
+			continue
+		}
+
+		if pkgDesc.Pkg != nil && importPath == pkgDesc.Pkg.Path() {
+			continue
+		}
+
+		paths = append(paths, importPath)
+	}
+
+	slices.SortFunc(paths, func(a, b string) int {
+		if isStdImport(a) != isStdImport(b) {
+			if isStdImport(a) {
+				return -1
+			}
+
+			return 1
+		}
+
+		return cmp.Compare(a, b)
+	})
+
+	aliases := map[string]string{}
+
+	if opts.testifyAlias() != defaultTestifyAlias {
+		aliases[testifyMockPkg] = opts.testifyAlias()
+	}
+
+	for _, importPath := range paths {
+		name := names[importPath]
+
+		alias := name
+		for i := 2; isNameTaken(pkgDesc, taken, alias); i++ {
+			alias = name + strconv.Itoa(i)
+		}
+
+		taken[alias] = struct{}{}
+
+		if alias != name {
+			aliases[importPath] = alias
+		}
+	}
+
+	return aliases
+}
+
+// getPackageNames returns the names (by import path) of the packages used by the methods of the interfaces.
+func getPackageNames(pkgDesc PackageDesc) map[string]string {
+	names := map[string]string{}
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		for _, method := range interfaceDesc.Methods {
+			_ = types.TypeString(method.Signature(), func(pkg *types.Package) string {
+				names[pkg.Path()] = pkg.Name()
+				return pkg.Name()
+			})
+		}
+	}
+
+	return names
+}
+
+// getImportNames returns the sorted names of the imports of the generated file, aliases included.
+// The imports required by the template are named after the last element of their import path.
+func getImportNames(pkgDesc PackageDesc, aliases map[string]string) []string {
+	packageNames := getPackageNames(pkgDesc)
+
+	var names []string
+	// time and mock are always imported by the generated file (see quickGoImports).
+	for _, importPath := range slices.Concat(slices.Collect(maps.Keys(pkgDesc.Imports)), []string{"time", testifyMockPkg}) {
+		if importPath == "" {
+			continue
+		}
+
+		name, ok := aliases[importPath]
+		if !ok {
+			name, ok = packageNames[importPath]
+		}
+
+		if !ok {
+			name = path.Base(importPath)
+		}
+
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return slices.Compact(names)
+}
+
+// isNameTaken reports whether the name is already used by an import or by an identifier of the package scope.
+func isNameTaken(pkgDesc PackageDesc, taken map[string]struct{}, name string) bool {
+	if _, ok := taken[name]; ok {
+		return true
+	}
+
+	return pkgDesc.Pkg != nil && pkgDesc.Pkg.Scope().Lookup(name) != nil
+}
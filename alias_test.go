@@ -0,0 +1,95 @@
+package main
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getImportAliases(t *testing.T) {
+	pkg := types.NewPackage("github.com/foo/a", "a")
+	pkg.Scope().Insert(types.NewTypeName(token.NoPos, pkg, "json", types.Typ[types.Int]))
+
+	newMethod := func(name string, pkgs ...*types.Package) *types.Func {
+		var vars []*types.Var
+		for _, p := range pkgs {
+			obj := types.NewTypeName(token.NoPos, p, "Result", nil)
+			vars = append(vars, types.NewVar(token.NoPos, pkg, "", types.NewNamed(obj, types.Typ[types.Int], nil)))
+		}
+
+		return types.NewFunc(token.NoPos, pkg, name, types.NewSignatureType(nil, nil, nil, nil, types.NewTuple(vars...), false))
+	}
+
+	pkgDesc := PackageDesc{
+		Pkg: pkg,
+		Interfaces: []InterfaceDesc{{
+			Name: "Database",
+			Methods: []*types.Func{
+				newMethod("Exec", types.NewPackage("github.com/foo/sql", "sql"), types.NewPackage("database/sql", "sql")),
+				newMethod("Mock", types.NewPackage("github.com/foo/mock", "mock")),
+				newMethod("Time", types.NewPackage("time", "time")),
+				newMethod("JSON", types.NewPackage("encoding/json", "json")),
+				newMethod("Self", pkg),
+			},
+		}},
+	}
+
+	expected := map[string]string{
+		"github.com/foo/sql":  "sql2",
+		"github.com/foo/mock": "mock2",
+		"encoding/json":       "json2",
+	}
+
+	assert.Equal(t, expected, getImportAliases(pkgDesc, Options{}, []string{"testing", "time", "sync", "fmt", testifyMockPkg}))
+}
+
+func Test_getImportAliases_testifyAlias(t *testing.T) {
+	pkg := types.NewPackage("github.com/foo/a", "a")
+
+	obj := types.NewTypeName(token.NoPos, types.NewPackage("github.com/foo/tmock", "tmock"), "Result", nil)
+	result := types.NewVar(token.NoPos, pkg, "", types.NewNamed(obj, types.Typ[types.Int], nil))
+
+	pkgDesc := PackageDesc{
+		Pkg: pkg,
+		Interfaces: []InterfaceDesc{{
+			Name: "Database",
+			Methods: []*types.Func{
+				types.NewFunc(token.NoPos, pkg, "Exec", types.NewSignatureType(nil, nil, nil, nil, types.NewTuple(result), false)),
+			},
+		}},
+	}
+
+	expected := map[string]string{
+		testifyMockPkg:         "tmock",
+		"github.com/foo/tmock": "tmock2",
+	}
+
+	assert.Equal(t, expected, getImportAliases(pkgDesc, Options{TestifyAlias: "tmock"}, []string{"time", testifyMockPkg}))
+}
+
+func Test_getImportAliases_debugMethods(t *testing.T) {
+	pkg := types.NewPackage("github.com/foo/a", "a")
+
+	obj := types.NewTypeName(token.NoPos, types.NewPackage("github.com/foo/strings", "strings"), "Builder", nil)
+	param := types.NewVar(token.NoPos, pkg, "b", types.NewNamed(obj, types.Typ[types.Int], nil))
+
+	pkgDesc := PackageDesc{
+		Pkg: pkg,
+		Interfaces: []InterfaceDesc{{
+			Name: "Printer",
+			Methods: []*types.Func{
+				types.NewFunc(token.NoPos, pkg, "Print", types.NewSignatureType(nil, nil, nil, types.NewTuple(param), nil, false)),
+			},
+		}},
+	}
+
+	// The package is not aliased when the template doesn't import strings.
+	assert.Empty(t, getImportAliases(pkgDesc, Options{}, []string{"time", testifyMockPkg}))
+
+	// The debug methods import strings.
+	expected := map[string]string{"github.com/foo/strings": "strings2"}
+
+	assert.Equal(t, expected, getImportAliases(pkgDesc, Options{DebugMethods: true}, []string{"time", testifyMockPkg, "fmt", "io", "os", "strings"}))
+}
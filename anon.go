@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadAnonymousInterfaces adds to the model the variables typed with an anonymous interface.
+// An anchor (see Options.AnonAt) is the position (file.go:line) of a variable declaration, the file path is relative to the root.
+// The mock is named after the variable.
+func loadAnonymousInterfaces(ctx context.Context, root string, opts Options, model map[string]PackageDesc) error {
+	for _, anchor := range opts.AnonAt {
+		file, line, err := parseAnchor(anchor)
+		if err != nil {
+			return err
+		}
+
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(root, file)
+		}
+
+		pkgs, err := packages.Load(
+			&packages.Config{
+				Context: ctx,
+				Mode:    loadMode(packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo),
+				Dir:     filepath.Dir(file),
+				// The variables of the test files are only declared by the test variants of the package.
+				Tests: strings.HasSuffix(file, "_test.go"),
+			},
+			".",
+		)
+		if err != nil {
+			return fmt.Errorf("load package of %q: %w", anchor, err)
+		}
+
+		pkg := findFilePackage(pkgs, file)
+		if pkg == nil {
+			return fmt.Errorf("no package contains the file of %q", anchor)
+		}
+
+		name, interfaceType := findAnonymousInterface(pkg, file, line)
+		if interfaceType == nil {
+			return fmt.Errorf("no variable typed with an anonymous interface at %q", anchor)
+		}
+
+		key := filepath.Join(filepath.Dir(file), srcMockFile)
+
+		packageDesc, ok := model[key]
+		if !ok {
+			packageDesc = PackageDesc{Pkg: pkg.Types, Imports: map[string]struct{}{}}
+		}
+
+		interfaceDesc := InterfaceDesc{Name: name, PkgPath: pkg.PkgPath, Position: token.Position{Filename: file, Line: line}}
+
+		for method := range interfaceType.Methods() {
+			interfaceDesc.Methods = append(interfaceDesc.Methods, method)
+
+			for _, imp := range getMethodImports(method, packageDesc.Pkg.Path(), opts.replacements()) {
+				packageDesc.Imports[imp] = struct{}{}
+			}
+		}
+
+		packageDesc.Interfaces = append(packageDesc.Interfaces, interfaceDesc)
+
+		model[key] = packageDesc
+	}
+
+	return nil
+}
+
+// findFilePackage returns the package compiling the file, among the packages loaded from its directory.
+// A directory can yield several packages (the package, its test variants, its external test package):
+// the first one is not necessarily the package of the file.
+func findFilePackage(pkgs []*packages.Package, file string) *packages.Package {
+	file = filepath.Clean(file)
+
+	for _, pkg := range pkgs {
+		if slices.Contains(pkg.CompiledGoFiles, file) {
+			return pkg
+		}
+	}
+
+	return nil
+}
+
+// findAnonymousInterface finds the variable declared at the line of the file and typed with an anonymous interface.
+func findAnonymousInterface(pkg *packages.Package, file string, line int) (string, *types.Interface) {
+	for _, f := range pkg.Syntax {
+		if pkg.Fset.File(f.Pos()).Name() != file {
+			continue
+		}
+
+		var name string
+		var interfaceType *types.Interface
+
+		ast.Inspect(f, func(node ast.Node) bool {
+			if interfaceType != nil {
+				return false
+			}
+
+			spec, ok := node.(*ast.ValueSpec)
+			if !ok {
+				return true
+			}
+
+			if pkg.Fset.Position(spec.Pos()).Line != line || len(spec.Names) == 0 {
+				return true
+			}
+
+			if _, ok := spec.Type.(*ast.InterfaceType); !ok {
+				return true
+			}
+
+			if iface, ok := pkg.TypesInfo.TypeOf(spec.Type).(*types.Interface); ok {
+				name = spec.Names[0].Name
+				interfaceType = iface
+			}
+
+			return false
+		})
+
+		return name, interfaceType
+	}
+
+	return "", nil
+}
+
+// parseAnchor parses a position (file.go:line).
+func parseAnchor(anchor string) (string, int, error) {
+	i := strings.LastIndex(anchor, ":")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("invalid anchor %q: the expected format is file.go:line", anchor)
+	}
+
+	file := anchor[:i]
+
+	line, err := strconv.Atoi(anchor[i+1:])
+	if err != nil || line <= 0 {
+		return "", 0, fmt.Errorf("invalid anchor %q: the expected format is file.go:line", anchor)
+	}
+
+	return file, line, nil
+}
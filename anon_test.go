@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_loadAnonymousInterfaces(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root, err := filepath.Abs("./testdata/src/a")
+	require.NoError(t, err)
+
+	anchor := "a.go:" + strconv.Itoa(findLine(t, filepath.Join(root, "a.go"), "var Handler interface"))
+
+	model := map[string]PackageDesc{}
+
+	err = loadAnonymousInterfaces(t.Context(), root, Options{AnonAt: []string{anchor}}, model)
+	require.NoError(t, err)
+
+	packageDesc, ok := model[filepath.Join(root, srcMockFile)]
+	require.True(t, ok)
+
+	assert.Equal(t, "a", packageDesc.Pkg.Path())
+	assert.Contains(t, packageDesc.Imports, "context")
+
+	require.Len(t, packageDesc.Interfaces, 1)
+	assert.Equal(t, "Handler", packageDesc.Interfaces[0].Name)
+
+	require.Len(t, packageDesc.Interfaces[0].Methods, 1)
+	assert.Equal(t, "Handle", packageDesc.Interfaces[0].Methods[0].Name())
+}
+
+func Test_loadAnonymousInterfaces_notFound(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root, err := filepath.Abs("./testdata/src/a")
+	require.NoError(t, err)
+
+	err = loadAnonymousInterfaces(t.Context(), root, Options{AnonAt: []string{"a.go:1"}}, map[string]PackageDesc{})
+	require.Error(t, err)
+}
+
+func Test_loadAnonymousInterfaces_externalTest(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":      "module a\n\ngo 1.18\n",
+		"a.go":        "package a\n\nvar Reader interface{ Read() string }\n",
+		"a_test.go":   "package a\n\nvar Writer interface{ Write(s string) }\n",
+		"ext_test.go": "package a_test\n\nvar Closer interface{ Close() error }\n",
+	}
+
+	writeTree(t, root, files)
+
+	// The file is compiled by the external test package only, which is not the first loaded package.
+	model := map[string]PackageDesc{}
+
+	err := loadAnonymousInterfaces(t.Context(), root, Options{AnonAt: []string{"ext_test.go:3"}}, model)
+	require.NoError(t, err)
+
+	packageDesc, ok := model[filepath.Join(root, srcMockFile)]
+	require.True(t, ok)
+
+	assert.Equal(t, "a_test", packageDesc.Pkg.Path())
+
+	require.Len(t, packageDesc.Interfaces, 1)
+	assert.Equal(t, "Closer", packageDesc.Interfaces[0].Name)
+	assert.Equal(t, "Close", packageDesc.Interfaces[0].Methods[0].Name())
+}
+
+func Test_parseAnchor(t *testing.T) {
+	file, line, err := parseAnchor("foo/bar.go:12")
+	require.NoError(t, err)
+
+	assert.Equal(t, "foo/bar.go", file)
+	assert.Equal(t, 12, line)
+
+	for _, anchor := range []string{"foo/bar.go", ":12", "foo/bar.go:0", "foo/bar.go:a"} {
+		_, _, err = parseAnchor(anchor)
+		require.Error(t, err, anchor)
+	}
+}
+
+func findLine(t *testing.T, file, prefix string) int {
+	t.Helper()
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = f.Close() })
+
+	scanner := bufio.NewScanner(f)
+	for i := 1; scanner.Scan(); i++ {
+		if strings.HasPrefix(scanner.Text(), prefix) {
+			return i
+		}
+	}
+
+	require.NoError(t, scanner.Err())
+	require.FailNow(t, "line not found", prefix)
+
+	return 0
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// writeDiff writes the unified diff between the current content of the generated file and its new content.
+// A missing file is diffed as an empty file, nothing is written when the contents are equal.
+func writeDiff(w io.Writer, out string, source []byte) error {
+	current, err := os.ReadFile(out)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("read file: %w", err)
+	}
+
+	name := filepath.ToSlash(relativePath(out))
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(current)),
+		B:        splitLines(string(source)),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("diff %s: %w", name, err)
+	}
+
+	_, err = io.WriteString(w, diff)
+
+	return err
+}
+
+// splitLines splits the content into lines, keeping the line endings.
+// Unlike difflib.SplitLines, it doesn't add an empty line after the final line ending.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
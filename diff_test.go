@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_writeDiff(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	out := filepath.Join(dir, "c", outputMockFile)
+
+	err := os.MkdirAll(filepath.Dir(out), 0o750)
+	require.NoError(t, err)
+
+	err = os.WriteFile(out, []byte("package c\n\nfunc Hello() {}\n\nfunc World() {}\n"), 0o600)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+
+	err = writeDiff(&buffer, out, []byte("package c\n\nfunc Hello() {}\n\nfunc Moon() {}\n"))
+	require.NoError(t, err)
+
+	expected := `--- a/c/mock_gen_test.go
++++ b/c/mock_gen_test.go
+@@ -2,4 +2,4 @@
+ 
+ func Hello() {}
+ 
+-func World() {}
++func Moon() {}
+`
+
+	assert.Equal(t, expected, buffer.String())
+
+	// The file is not written.
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), "World")
+}
+
+func Test_writeDiff_unchanged(t *testing.T) {
+	out := filepath.Join(t.TempDir(), outputMockFile)
+
+	err := os.WriteFile(out, []byte("package c\n"), 0o600)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+
+	err = writeDiff(&buffer, out, []byte("package c\n"))
+	require.NoError(t, err)
+
+	assert.Empty(t, buffer.String())
+}
+
+func Test_writeDiff_missingFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	var buffer bytes.Buffer
+
+	err := writeDiff(&buffer, filepath.Join(dir, outputMockFile), []byte("package c\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "--- a/mock_gen_test.go\n+++ b/mock_gen_test.go\n@@ -0,0 +1 @@\n+package c\n", buffer.String())
+}
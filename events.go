@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+)
+
+// Events of the generation, logged as JSON objects with the log-json option.
+const (
+	eventLoaded    = "loaded"    // a package declaring interfaces is loaded.
+	eventGenerated = "generated" // the mock of an interface is generated.
+	eventWritten   = "written"   // a file of mocks is written.
+	eventUnchanged = "unchanged" // a file of mocks is up to date (incremental generation).
+	eventPruned    = "pruned"    // a stale file of mocks is removed.
+	eventSummary   = "summary"   // the counters of the run.
+	eventError     = "error"     // the run failed.
+)
+
+// newJSONLogger returns a logger writing a JSON object per line.
+// Set as the default logger, it also formats the output of the standard logger.
+func newJSONLogger(w io.Writer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(w, nil))
+}
+
+// logEvent logs a significant event of the generation.
+// With the log-json option, the event is a JSON object with its attributes, logged by the default logger.
+// Otherwise, only the message is logged by the standard logger: the events without message are silent.
+func (o Options) logEvent(event, msg string, args ...any) {
+	if !o.LogJSON {
+		if msg != "" {
+			log.Print(msg)
+		}
+
+		return
+	}
+
+	level := slog.LevelInfo
+	if event == eventError {
+		level = slog.LevelError
+	}
+
+	slog.Log(context.Background(), level, msg, append([]any{"event", event}, args...)...)
+}
+
+// fatalf logs an error event and exits, like log.Fatalf.
+func (o Options) fatalf(format string, args ...any) {
+	o.logEvent(eventError, fmt.Sprintf(format, args...))
+	os.Exit(1)
+}
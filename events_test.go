@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions_logEvent(t *testing.T) {
+	defaultLogger := slog.Default()
+	t.Cleanup(func() {
+		// The default logger redirects the standard logger, which is not restored by slog.
+		slog.SetDefault(defaultLogger)
+		log.SetOutput(os.Stderr)
+		log.SetFlags(log.LstdFlags)
+	})
+
+	var buffer bytes.Buffer
+	slog.SetDefault(newJSONLogger(&buffer))
+
+	opts := Options{LogJSON: true}
+	opts.logEvent(eventLoaded, "", "package", "a/b")
+	opts.logEvent(eventWritten, "b/mock_gen_test.go", "file", "b/mock_gen_test.go", "interfaces", 2)
+	opts.logEvent(eventError, "walk: boom")
+
+	// The output of the standard logger is also a JSON object.
+	log.Printf("Unable to find: %s", "Carrot")
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	require.Len(t, lines, 4)
+
+	var events []map[string]any
+	for _, line := range lines {
+		var event map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+
+		delete(event, "time")
+		events = append(events, event)
+	}
+
+	expected := []map[string]any{
+		{"level": "INFO", "msg": "", "event": eventLoaded, "package": "a/b"},
+		{"level": "INFO", "msg": "b/mock_gen_test.go", "event": eventWritten, "file": "b/mock_gen_test.go", "interfaces": float64(2)},
+		{"level": "ERROR", "msg": "walk: boom", "event": eventError},
+		{"level": "INFO", "msg": "Unable to find: Carrot"},
+	}
+
+	assert.Equal(t, expected, events)
+}
+
+func TestOptions_logEvent_text(t *testing.T) {
+	var buffer bytes.Buffer
+
+	log.SetOutput(&buffer)
+	log.SetFlags(0)
+	t.Cleanup(func() {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(log.LstdFlags)
+	})
+
+	opts := Options{}
+	opts.logEvent(eventLoaded, "", "package", "a/b")
+	opts.logEvent(eventWritten, "b/mock_gen_test.go", "file", "b/mock_gen_test.go")
+
+	assert.Equal(t, "b/mock_gen_test.go\n", buffer.String())
+}
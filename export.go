@@ -0,0 +1,65 @@
+package main
+
+import (
+	"slices"
+)
+
+// mockFile is the generation of a file of mocks: the interfaces of a package and the options of the file.
+type mockFile struct {
+	pkgDesc PackageDesc
+	opts    Options
+}
+
+// splitExported returns the files of mocks of a package.
+// With the export option, the exported mocks of the selected interfaces are generated into the non-test file (like `-e`),
+// and the mocks of the other interfaces into the test file. The empty files are omitted.
+func splitExported(pkgDesc PackageDesc, opts Options) []mockFile {
+	if len(opts.Export) == 0 {
+		return []mockFile{{pkgDesc: pkgDesc, opts: opts}}
+	}
+
+	var exported, others []InterfaceDesc
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		if slices.Contains(opts.Export, interfaceDesc.Name) {
+			exported = append(exported, interfaceDesc)
+		} else {
+			others = append(others, interfaceDesc)
+		}
+	}
+
+	exportedOpts := opts
+	exportedOpts.Exported = true
+
+	var files []mockFile
+
+	if len(others) > 0 {
+		files = append(files, mockFile{pkgDesc: withInterfaces(pkgDesc, others, opts), opts: opts})
+	}
+
+	if len(exported) > 0 {
+		files = append(files, mockFile{pkgDesc: withInterfaces(pkgDesc, exported, opts), opts: exportedOpts})
+	}
+
+	return files
+}
+
+// withInterfaces returns the description of the package restricted to the interfaces,
+// with the imports of their methods and of their constraints.
+func withInterfaces(pkgDesc PackageDesc, interfaces []InterfaceDesc, opts Options) PackageDesc {
+	desc := PackageDesc{Pkg: pkgDesc.Pkg, Imports: map[string]struct{}{}, Interfaces: interfaces}
+
+	for _, interfaceDesc := range interfaces {
+		for _, method := range interfaceDesc.Methods {
+			for _, imp := range getMethodImports(method, pkgDesc.Pkg.Path(), opts.replacements()) {
+				desc.Imports[imp] = struct{}{}
+			}
+		}
+
+		for _, imp := range getConstraintImports(interfaceDesc.TypeParams, pkgDesc.Pkg.Path(), opts.replacements()) {
+			desc.Imports[imp] = struct{}{}
+		}
+	}
+
+	return desc
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_splitExported(t *testing.T) {
+	pkg := types.NewPackage("example.com/a", "a")
+
+	newMethod := func(name string, result *types.Package) *types.Func {
+		obj := types.NewTypeName(token.NoPos, result, "Result", nil)
+		results := types.NewTuple(types.NewVar(token.NoPos, pkg, "", types.NewNamed(obj, types.Typ[types.Int], nil)))
+
+		return types.NewFunc(token.NoPos, pkg, name, types.NewSignatureType(nil, nil, nil, nil, results, false))
+	}
+
+	pkgDesc := PackageDesc{
+		Pkg:     pkg,
+		Imports: map[string]struct{}{"example.com/b": {}, "example.com/c": {}},
+		Interfaces: []InterfaceDesc{
+			{Name: "Pineapple", Methods: []*types.Func{newMethod("World", types.NewPackage("example.com/b", "b"))}},
+			{Name: "Coconut", Methods: []*types.Func{newMethod("Loo", types.NewPackage("example.com/c", "c"))}},
+		},
+	}
+
+	files := splitExported(pkgDesc, Options{})
+	require.Len(t, files, 1)
+	assert.Equal(t, pkgDesc, files[0].pkgDesc)
+
+	files = splitExported(pkgDesc, Options{Export: []string{"Pineapple"}})
+	require.Len(t, files, 2)
+
+	assert.False(t, files[0].opts.Exported)
+	assert.Equal(t, "Coconut", files[0].pkgDesc.Interfaces[0].Name)
+	assert.Equal(t, map[string]struct{}{"example.com/c": {}}, files[0].pkgDesc.Imports)
+
+	assert.True(t, files[1].opts.Exported)
+	assert.Equal(t, "Pineapple", files[1].pkgDesc.Interfaces[0].Name)
+	assert.Equal(t, map[string]struct{}{"example.com/b": {}}, files[1].pkgDesc.Imports)
+
+	files = splitExported(pkgDesc, Options{Export: []string{"Pineapple", "Coconut"}})
+	require.Len(t, files, 1)
+
+	assert.True(t, files[0].opts.Exported)
+	assert.Len(t, files[0].pkgDesc.Interfaces, 2)
+}
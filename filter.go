@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/types"
+	"os"
+	"slices"
+	"strings"
+)
+
+// parseInterfaceFilter parses the value of the flag `-interface`:
+// a comma-separated list of interface names,
+// or `@file` to read the names from a file (one name per line, `#` starts a comment).
+func parseInterfaceFilter(value string) ([]string, error) {
+	filename, ok := strings.CutPrefix(value, "@")
+	if !ok {
+		var names []string
+		for name := range strings.SplitSeq(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+
+		return names, nil
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("interface filter: %w", err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	var names []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+
+		if name := strings.TrimSpace(line); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names, scanner.Err()
+}
+
+// isInterfaceSelected reports whether the interface referenced by a comment passes the interface filter.
+// A name of the filter matches the name used in the comment (e.g. `b.Carrot`) or the name of the interface (e.g. `Carrot`).
+func (o Options) isInterfaceSelected(name string) bool {
+	if len(o.Interfaces) == 0 {
+		return true
+	}
+
+	for _, selected := range o.Interfaces {
+		if o.matchInterface(selected, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchInterface reports whether the interface matches an entry of the interface filter,
+// with its name or its short name (without the package prefix), case-insensitively with the interface-ci option.
+func (o Options) matchInterface(selected, name string) bool {
+	short := name[strings.LastIndex(name, ".")+1:]
+
+	if o.InterfaceCI {
+		return strings.EqualFold(selected, name) || strings.EqualFold(selected, short)
+	}
+
+	return selected == name || selected == short
+}
+
+// checkInterfaceCollisions returns an error when an entry of the interface filter matches case-insensitively
+// several interfaces whose names differ only by the case (e.g. `PiniaColada` and `Piniacolada`).
+func (o Options) checkInterfaceCollisions(names []string) error {
+	if !o.InterfaceCI {
+		return nil
+	}
+
+	for _, selected := range o.Interfaces {
+		var matched []string
+
+		for _, name := range names {
+			short := name[strings.LastIndex(name, ".")+1:]
+			if o.matchInterface(selected, name) && !slices.Contains(matched, short) {
+				matched = append(matched, short)
+			}
+		}
+
+		if len(matched) > 1 {
+			return fmt.Errorf("the interface filter %q matches several interfaces case-insensitively: %s", selected, strings.Join(matched, ", "))
+		}
+	}
+
+	return nil
+}
+
+// isMethodSelected reports whether the method passes the method filter (`Interface.Method`).
+// All the methods of an interface without entry in the filter are selected.
+func (o Options) isMethodSelected(interfaceName, methodName string) bool {
+	var filtered bool
+
+	for _, selected := range o.Methods {
+		name, method, _ := strings.Cut(selected, ".")
+		if name != interfaceName {
+			continue
+		}
+
+		if method == methodName {
+			return true
+		}
+
+		filtered = true
+	}
+
+	return !filtered
+}
+
+// checkMethodFilter returns an error if the method filter references a method not declared by the interface.
+func (o Options) checkMethodFilter(interfaceDesc InterfaceDesc) error {
+	for _, selected := range o.Methods {
+		name, method, _ := strings.Cut(selected, ".")
+		if name != interfaceDesc.Name {
+			continue
+		}
+
+		if !slices.ContainsFunc(interfaceDesc.Methods, func(m *types.Func) bool { return m.Name() == method }) {
+			return fmt.Errorf("method filter: the interface %s has no method %s", name, method)
+		}
+	}
+
+	return nil
+}
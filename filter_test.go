@@ -0,0 +1,92 @@
+package main
+
+import (
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseInterfaceFilter(t *testing.T) {
+	names, err := parseInterfaceFilter("Pineapple, b.Carrot,,Coconut")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Pineapple", "b.Carrot", "Coconut"}, names)
+}
+
+func Test_parseInterfaceFilter_file(t *testing.T) {
+	fp := filepath.Join(t.TempDir(), "interfaces.txt")
+
+	err := os.WriteFile(fp, []byte("# fruits\nPineapple\n\n  b.Carrot # vegetable\nCoconut\n"), 0o600)
+	require.NoError(t, err)
+
+	names, err := parseInterfaceFilter("@" + fp)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Pineapple", "b.Carrot", "Coconut"}, names)
+
+	_, err = parseInterfaceFilter("@" + filepath.Join(t.TempDir(), "missing.txt"))
+	require.Error(t, err)
+}
+
+func TestOptions_isInterfaceSelected(t *testing.T) {
+	assert.True(t, Options{}.isInterfaceSelected("Pineapple"))
+
+	opts := Options{Interfaces: []string{"Pineapple", "Carrot", "d.Cherry"}}
+
+	assert.True(t, opts.isInterfaceSelected("Pineapple"))
+	assert.True(t, opts.isInterfaceSelected("b.Carrot"))
+	assert.True(t, opts.isInterfaceSelected("d.Cherry"))
+	assert.False(t, opts.isInterfaceSelected("Cherry"))
+	assert.False(t, opts.isInterfaceSelected("Coconut"))
+	assert.False(t, opts.isInterfaceSelected("pineapple"))
+}
+
+func TestOptions_isInterfaceSelected_caseInsensitive(t *testing.T) {
+	opts := Options{Interfaces: []string{"piniacolada", "B.CARROT"}, InterfaceCI: true}
+
+	assert.True(t, opts.isInterfaceSelected("PiniaColada"))
+	assert.True(t, opts.isInterfaceSelected("b.Carrot"))
+	assert.False(t, opts.isInterfaceSelected("Carrot"))
+	assert.False(t, opts.isInterfaceSelected("Pineapple"))
+}
+
+func TestOptions_checkInterfaceCollisions(t *testing.T) {
+	opts := Options{Interfaces: []string{"piniacolada", "carrot"}}
+
+	require.NoError(t, opts.checkInterfaceCollisions([]string{"PiniaColada", "Piniacolada"}))
+
+	opts.InterfaceCI = true
+
+	require.NoError(t, opts.checkInterfaceCollisions([]string{"PiniaColada", "Pineapple"}))
+	require.NoError(t, opts.checkInterfaceCollisions([]string{"Carrot", "b.Carrot"}))
+
+	err := opts.checkInterfaceCollisions([]string{"PiniaColada", "Piniacolada"})
+	require.EqualError(t, err, `the interface filter "piniacolada" matches several interfaces case-insensitively: PiniaColada, Piniacolada`)
+}
+
+func TestOptions_isMethodSelected(t *testing.T) {
+	assert.True(t, Options{}.isMethodSelected("Pineapple", "Hello"))
+
+	opts := Options{Methods: []string{"Pineapple.Hello", "Pineapple.World"}}
+
+	assert.True(t, opts.isMethodSelected("Pineapple", "Hello"))
+	assert.True(t, opts.isMethodSelected("Pineapple", "World"))
+	assert.False(t, opts.isMethodSelected("Pineapple", "Goo"))
+	assert.True(t, opts.isMethodSelected("Coconut", "Goo"))
+}
+
+func TestOptions_checkMethodFilter(t *testing.T) {
+	newMethod := func(name string) *types.Func {
+		return types.NewFunc(token.NoPos, nil, name, types.NewSignatureType(nil, nil, nil, nil, nil, false))
+	}
+
+	interfaceDesc := InterfaceDesc{Name: "Pineapple", Methods: []*types.Func{newMethod("Hello"), newMethod("World")}}
+
+	require.NoError(t, Options{Methods: []string{"Pineapple.Hello", "Coconut.Goo"}}.checkMethodFilter(interfaceDesc))
+	require.Error(t, Options{Methods: []string{"Pineapple.Goo"}}.checkMethodFilter(interfaceDesc))
+}
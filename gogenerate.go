@@ -0,0 +1,314 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const goGeneratePrefix = "//go:generate "
+
+// generateDirective represents a `//go:generate mocktail` directive.
+type generateDirective struct {
+	Dir     string   // directory of the file containing the directive.
+	Command []string // command of a `go run path/to/mocktail[@version]` directive, the running mocktail if empty.
+	Args    []string // mocktail arguments.
+	Env     []string // variables of go generate (e.g. GOFILE), set in the environment of the command.
+}
+
+// runGoGenerateDirectives finds all the `//go:generate mocktail` directives and runs them in their directory.
+// The `mocktail` directives are run with the running mocktail, the `go run` directives with their command (e.g. a pinned version).
+func runGoGenerateDirectives(ctx context.Context, root string, opts Options) error {
+	directives, err := findGoGenerateDirectives(root, opts)
+	if err != nil {
+		return err
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("executable: %w", err)
+	}
+
+	for _, directive := range directives {
+		var cmd *exec.Cmd
+
+		if len(directive.Command) == 0 {
+			log.Printf("%s: mocktail %s (run with %s)", directive.Dir, strings.Join(directive.Args, " "), exe)
+
+			cmd = exec.CommandContext(ctx, exe, directive.Args...)
+		} else {
+			log.Printf("%s: %s %s", directive.Dir, strings.Join(directive.Command, " "), strings.Join(directive.Args, " "))
+
+			cmd = exec.CommandContext(ctx, directive.Command[0], append(directive.Command[1:], directive.Args...)...)
+		}
+
+		cmd.Dir = directive.Dir
+		cmd.Env = append(os.Environ(), directive.Env...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		err = cmd.Run()
+		if err != nil {
+			return fmt.Errorf("command %q in %q: %w", strings.Join(cmd.Args, " "), directive.Dir, err)
+		}
+	}
+
+	return nil
+}
+
+func findGoGenerateDirectives(root string, opts Options) ([]generateDirective, error) {
+	var directives []generateDirective
+
+	seen := map[string]struct{}{}
+
+	err := filepath.WalkDir(root, func(fp string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == "testdata" || d.Name() == "vendor" || opts.isIgnored(root, fp) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(d.Name()) != ".go" || opts.isIgnored(root, fp) {
+			return nil
+		}
+
+		file, err := os.Open(fp)
+		if err != nil {
+			return err
+		}
+
+		defer func() { _ = file.Close() }()
+
+		var pkgName string
+
+		scanner := bufio.NewScanner(file)
+		for line := 1; scanner.Scan(); line++ {
+			text := scanner.Text()
+			if !strings.HasPrefix(text, goGeneratePrefix) {
+				continue
+			}
+
+			if pkgName == "" {
+				pkgName, err = packageClause(fp)
+				if err != nil {
+					return err
+				}
+			}
+
+			directive, ok, err := parseGoGenerateDirective(text, goGenerateEnv(fp, line, pkgName))
+			if err != nil {
+				return fmt.Errorf("%s:%d: go:generate directive: %w", fp, line, err)
+			}
+
+			if !ok {
+				continue
+			}
+
+			directive.Dir = filepath.Dir(fp)
+
+			// The same directive is often repeated in several files of a package.
+			key := directive.Dir + "\x00" + strings.Join(append(directive.Command, directive.Args...), "\x00")
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+
+			directives = append(directives, directive)
+		}
+
+		return scanner.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk dir: %w", err)
+	}
+
+	return directives, nil
+}
+
+// parseGoGenerateDirective extracts the command and the mocktail arguments from a `//go:generate` line.
+// Both `mocktail [args]` and `go run path/to/mocktail[@version] [args]` are supported.
+// Like `go generate`, the variables (e.g. $GOFILE) are expanded with the environment of the directive, then with the environment of the process.
+func parseGoGenerateDirective(line string, env []string) (generateDirective, bool, error) {
+	if !strings.HasPrefix(line, goGeneratePrefix) {
+		return generateDirective{}, false, nil
+	}
+
+	fields, err := splitGoGenerateArgs(strings.TrimPrefix(line, goGeneratePrefix))
+	if err != nil {
+		return generateDirective{}, false, err
+	}
+
+	for i, field := range fields {
+		fields[i] = os.Expand(field, func(name string) string {
+			for _, kv := range env {
+				if k, v, _ := strings.Cut(kv, "="); k == name {
+					return v
+				}
+			}
+
+			return os.Getenv(name)
+		})
+	}
+
+	directive := generateDirective{Env: env}
+
+	switch {
+	case len(fields) > 0 && fields[0] == "mocktail":
+		directive.Args = fields[1:]
+
+	case len(fields) > 2 && fields[0] == "go" && fields[1] == "run" && isMocktailPackage(fields[2]):
+		directive.Command = fields[:3:3]
+		directive.Args = fields[3:]
+
+	default:
+		return generateDirective{}, false, nil
+	}
+
+	all, err := isAllDirective(directive.Args)
+	if err != nil {
+		return generateDirective{}, false, err
+	}
+
+	// Avoids an infinite recursion.
+	if all {
+		return generateDirective{}, false, nil
+	}
+
+	return directive, true, nil
+}
+
+// goGenerateEnv returns the variables set by `go generate` for a directive of a file.
+func goGenerateEnv(fp string, line int, pkgName string) []string {
+	return []string{
+		"GOARCH=" + runtime.GOARCH,
+		"GOOS=" + runtime.GOOS,
+		"GOFILE=" + filepath.Base(fp),
+		"GOLINE=" + strconv.Itoa(line),
+		"GOPACKAGE=" + pkgName,
+		"DOLLAR=$",
+	}
+}
+
+// packageClause returns the name of the package of a Go file.
+func packageClause(fp string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), fp, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", fmt.Errorf("parse package clause: %w", err)
+	}
+
+	return file.Name.Name, nil
+}
+
+// splitGoGenerateArgs splits the arguments of a directive like `go generate`:
+// the arguments are separated by spaces or tabs, a double-quoted argument is a Go string.
+func splitGoGenerateArgs(line string) ([]string, error) {
+	line = strings.TrimSuffix(line, "\r")
+
+	words := []string{}
+
+Words:
+	for {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" {
+			return words, nil
+		}
+
+		if line[0] == '"' {
+			for i := 1; i < len(line); i++ {
+				switch line[i] {
+				case '\\':
+					if i+1 == len(line) {
+						return nil, errors.New("bad backslash")
+					}
+
+					i++
+
+				case '"':
+					word, err := strconv.Unquote(line[:i+1])
+					if err != nil {
+						return nil, errors.New("bad quoted string")
+					}
+
+					words = append(words, word)
+
+					line = line[i+1:]
+					if line != "" && line[0] != ' ' && line[0] != '\t' {
+						return nil, errors.New("expect space after quoted argument")
+					}
+
+					continue Words
+				}
+			}
+
+			return nil, errors.New("mismatched quoted string")
+		}
+
+		i := strings.IndexAny(line, " \t")
+		if i < 0 {
+			i = len(line)
+		}
+
+		words = append(words, line[:i])
+		line = line[i:]
+	}
+}
+
+// isAllDirective reports whether the mocktail arguments of a directive enable -all.
+// The arguments are parsed with the flags of the command, but without their effects (e.g. reading the file of -interface @file).
+func isAllDirective(args []string) (bool, error) {
+	var all bool
+
+	fs := flag.NewFlagSet("mocktail", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	newFlagSet(&Options{}, &cliOptions{}).VisitAll(func(f *flag.Flag) {
+		switch {
+		case f.Name == "all":
+			fs.BoolVar(&all, f.Name, false, f.Usage)
+		case isBoolFlag(f):
+			fs.Bool(f.Name, false, f.Usage)
+		default:
+			fs.String(f.Name, "", f.Usage)
+		}
+	})
+
+	err := fs.Parse(args)
+	if err != nil {
+		return false, err
+	}
+
+	return all, nil
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+
+	return ok && b.IsBoolFlag()
+}
+
+func isMocktailPackage(pkg string) bool {
+	pkg, _, _ = strings.Cut(pkg, "@")
+
+	return path.Base(pkg) == "mocktail"
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseGoGenerateDirective(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		line     string
+		env      []string
+		command  []string
+		expected []string
+		ok       bool
+		err      string
+	}{
+		{
+			desc:     "mocktail without arguments",
+			line:     "//go:generate mocktail",
+			expected: []string{},
+			ok:       true,
+		},
+		{
+			desc:     "mocktail with arguments",
+			line:     "//go:generate mocktail -e -mock-base=github.com/foo/ourmock.Mock",
+			expected: []string{"-e", "-mock-base=github.com/foo/ourmock.Mock"},
+			ok:       true,
+		},
+		{
+			desc:     "go run",
+			line:     "//go:generate go run github.com/paperballs/mocktail@latest -e",
+			command:  []string{"go", "run", "github.com/paperballs/mocktail@latest"},
+			expected: []string{"-e"},
+			ok:       true,
+		},
+		{
+			desc:     "go run with a version",
+			line:     "//go:generate go run github.com/paperballs/mocktail@v1.2.0",
+			command:  []string{"go", "run", "github.com/paperballs/mocktail@v1.2.0"},
+			expected: []string{},
+			ok:       true,
+		},
+		{
+			desc:     "variables",
+			line:     "//go:generate mocktail \"-banner=$GOPACKAGE/${GOFILE}:$GOLINE costs ${DOLLAR}1\" -e$UNKNOWN",
+			env:      []string{"GOFILE=a.go", "GOLINE=12", "GOPACKAGE=a_test", "DOLLAR=$"},
+			expected: []string{"-banner=a_test/a.go:12 costs $1", "-e"},
+			ok:       true,
+		},
+		{
+			desc:     "variable as command",
+			line:     "//go:generate $TOOL -e",
+			env:      []string{"TOOL=mocktail"},
+			expected: []string{"-e"},
+			ok:       true,
+		},
+		{
+			desc: "another generator",
+			line: "//go:generate stringer -type=Pill",
+		},
+		{
+			desc: "go run another generator",
+			line: "//go:generate go run golang.org/x/tools/cmd/stringer -type=Pill",
+		},
+		{
+			desc: "recursive",
+			line: "//go:generate mocktail -all",
+		},
+		{
+			desc: "recursive with a value",
+			line: "//go:generate mocktail -e -all=true",
+		},
+		{
+			desc: "recursive with two dashes",
+			line: "//go:generate go run github.com/paperballs/mocktail --all=1",
+		},
+		{
+			desc:     "all disabled",
+			line:     "//go:generate mocktail -all=false -e",
+			expected: []string{"-all=false", "-e"},
+			ok:       true,
+		},
+		{
+			desc:     "argument named like the flag",
+			line:     "//go:generate mocktail -interface -all",
+			expected: []string{"-interface", "-all"},
+			ok:       true,
+		},
+		{
+			desc:     "quoted arguments",
+			line:     "//go:generate mocktail -banner \"Code owned by\\nthe team\" \t\"-interface=Pineapple, Coconut\"",
+			expected: []string{"-banner", "Code owned by\nthe team", "-interface=Pineapple, Coconut"},
+			ok:       true,
+		},
+		{
+			desc: "mismatched quoted string",
+			line: "//go:generate mocktail -banner \"Code owned",
+			err:  "mismatched quoted string",
+		},
+		{
+			desc: "unknown flag",
+			line: "//go:generate mocktail -unknown",
+			err:  "flag provided but not defined: -unknown",
+		},
+		{
+			desc: "not a directive",
+			line: "// go:generate mocktail",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			directive, ok, err := parseGoGenerateDirective(test.line, test.env)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.command, directive.Command)
+			assert.Equal(t, test.expected, directive.Args)
+		})
+	}
+}
+
+func Test_runGoGenerateDirectives(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	// The command of the directive is run: a pinned version is not replaced by the running mocktail.
+	writeTree(t, root, map[string]string{
+		"go.mod": "module example.com/gen\n\ngo 1.24\n",
+		"tools/mocktail/main.go": `package main
+
+import (
+	"os"
+	"strings"
+)
+
+func main() {
+	out := os.Getenv("GOFILE") + " " + os.Getenv("GOPACKAGE") + " " + strings.Join(os.Args[1:], " ")
+
+	err := os.WriteFile("generated.txt", []byte(out), 0o600)
+	if err != nil {
+		panic(err)
+	}
+}
+`,
+		"a/a.go": `package a
+
+//go:generate go run example.com/gen/tools/mocktail -e -banner=$GOFILE:$GOLINE
+`,
+	})
+
+	err := runGoGenerateDirectives(t.Context(), root, Options{})
+	require.NoError(t, err)
+
+	generated, err := os.ReadFile(filepath.Join(root, "a", "generated.txt"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "a.go a -e -banner=a.go:3", string(generated))
+}
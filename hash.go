@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/types"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+const hashCommentPrefix = "// mocktail:hash:"
+
+// interfacesHash computes a stable hash of the method sets of the interfaces of a package and of the generation options.
+func interfacesHash(pkgDesc PackageDesc, opts Options, tmpl *template.Template) string {
+	h := sha256.New()
+
+	// The options that don't change the generated content are not hashed.
+	opts.Incremental = false
+	opts.KeepGoing = false
+	opts.IncludeTests = false
+	opts.Since = ""
+	opts.Pattern = ""
+	opts.Prune = false
+	opts.Diff = false
+	opts.LogJSON = false
+
+	// -no-format is a shorthand of -format=none.
+	opts.Format = opts.formatting()
+	opts.NoFormat = false
+
+	// The default value of the options added later is not hashed: it's the behavior of the previous versions.
+	if opts.TestifyAlias == defaultTestifyAlias {
+		opts.TestifyAlias = ""
+	}
+
+	// Only the options that are set are hashed: adding a new option doesn't change the existing hashes.
+	v := reflect.ValueOf(opts)
+	for i := range v.NumField() {
+		if !v.Field(i).IsZero() {
+			_, _ = fmt.Fprintf(h, "%s=%v\n", v.Type().Field(i).Name, v.Field(i).Interface())
+		}
+	}
+
+	// The templates are hashed: a custom template, or the embedded template of another version, changes the generated content.
+	// The empty templates (e.g. the text of a file outside the definitions) are not executed.
+	if tmpl != nil {
+		templates := tmpl.Templates()
+		slices.SortFunc(templates, func(a, b *template.Template) int { return strings.Compare(a.Name(), b.Name()) })
+
+		for _, t := range templates {
+			if t.Tree != nil && t.Tree.Root != nil && !parse.IsEmptyTree(t.Tree.Root) {
+				_, _ = fmt.Fprintf(h, "template %s\n%s\n", t.Name(), t.Tree.Root)
+			}
+		}
+	}
+
+	if pkgDesc.Pkg != nil {
+		_, _ = fmt.Fprintf(h, "package %s %s\n", pkgDesc.Pkg.Path(), pkgDesc.Pkg.Name())
+	}
+
+	qualifier := func(pkg *types.Package) string { return pkg.Path() }
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		_, _ = fmt.Fprintf(h, "interface %s\n", interfaceDesc.Name)
+
+		if interfaceDesc.TypeParams != nil {
+			for tp := range interfaceDesc.TypeParams.TypeParams() {
+				_, _ = fmt.Fprintf(h, "\ttype %s %s\n", tp.Obj().Name(), types.TypeString(tp.Constraint(), qualifier))
+			}
+		}
+
+		for _, method := range interfaceDesc.Methods {
+			_, _ = fmt.Fprintf(h, "\tfunc %s%s\n", method.Name(), types.TypeString(method.Signature(), qualifier))
+		}
+
+		for _, name := range interfaceDesc.MustCall {
+			_, _ = fmt.Fprintf(h, "\tmust %s\n", name)
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// readHash returns the hash stored in the header of a generated file, or an empty string.
+func readHash(fp string) string {
+	file, err := os.Open(fp)
+	if err != nil {
+		return ""
+	}
+
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if hash, ok := strings.CutPrefix(line, hashCommentPrefix); ok {
+			return strings.TrimSpace(hash)
+		}
+
+		// The hash is written before the package clause.
+		if strings.HasPrefix(line, "package ") {
+			return ""
+		}
+	}
+
+	return ""
+}
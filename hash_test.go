@@ -0,0 +1,71 @@
+package main
+
+import (
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_interfacesHash(t *testing.T) {
+	pkg := types.NewPackage("a", "a")
+
+	newPkgDesc := func(result types.Type) PackageDesc {
+		results := types.NewTuple(types.NewVar(token.NoPos, pkg, "", result))
+		method := types.NewFunc(token.NoPos, pkg, "Open", types.NewSignatureType(nil, nil, nil, nil, results, false))
+
+		return PackageDesc{
+			Pkg:        pkg,
+			Interfaces: []InterfaceDesc{{Name: "Coconut", Methods: []*types.Func{method}}},
+		}
+	}
+
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	hash := interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{}, tmpl)
+
+	assert.Len(t, hash, 16)
+	assert.Equal(t, hash, interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{}, tmpl))
+	assert.Equal(t, hash, interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{Incremental: true}, tmpl))
+
+	assert.NotEqual(t, hash, interfacesHash(newPkgDesc(types.Typ[types.String]), Options{}, tmpl))
+	assert.NotEqual(t, hash, interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{Receiver: "m"}, tmpl))
+
+	assert.Equal(t, hash, interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{TestifyAlias: defaultTestifyAlias}, tmpl))
+	assert.NotEqual(t, hash, interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{TestifyAlias: "tmock"}, tmpl))
+
+	assert.NotEqual(t, hash, interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{NoFormat: true}, tmpl))
+	assert.Equal(t, interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{Format: formatNone}, tmpl), interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{NoFormat: true}, tmpl))
+
+	// Another template generates another content.
+	other, err := tmpl.Clone()
+	require.NoError(t, err)
+
+	_, err = other.New("imports").Parse("package {{ .Name }}\n")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, hash, interfacesHash(newPkgDesc(types.Typ[types.Int]), Options{}, other))
+}
+
+func Test_readHash(t *testing.T) {
+	dir := t.TempDir()
+
+	fp := filepath.Join(dir, outputMockFile)
+
+	assert.Empty(t, readHash(fp))
+
+	err := os.WriteFile(fp, []byte("// Code generated by mocktail; DO NOT EDIT.\n// mocktail:hash:abcd1234\n\npackage a\n"), 0o600)
+	require.NoError(t, err)
+
+	assert.Equal(t, "abcd1234", readHash(fp))
+
+	err = os.WriteFile(fp, []byte("// Code generated by mocktail; DO NOT EDIT.\n\npackage a\n\n// mocktail:hash:abcd1234\n"), 0o600)
+	require.NoError(t, err)
+
+	assert.Empty(t, readHash(fp))
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// inlineDelimiter separates the source file from the appended mocks.
+// It doesn't match the generated-code pattern (`^// Code generated .* DO NOT EDIT\.$`) on purpose:
+// the source file itself is not generated.
+const inlineDelimiter = "// ----- Mocks generated by mocktail below this line; DO NOT EDIT. -----"
+
+// generateInline appends the mocks of the package to the file declaring the interfaces.
+// fp is the path of the file containing the mocktail comments.
+func generateInline(fp string, pkgDesc PackageDesc, source []byte, opts Options) error {
+	var names []string
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		names = append(names, interfaceDesc.Name)
+	}
+
+	out, err := findInterfacesFile(filepath.Dir(fp), names)
+	if err != nil {
+		return fmt.Errorf("inline %s: %w", fp, err)
+	}
+
+	opts.logEvent(eventWritten, relativePath(out), "file", relativePath(out), "interfaces", len(pkgDesc.Interfaces))
+
+	err = writeInline(out, source)
+	if err != nil {
+		return fmt.Errorf("inline %s: %w", out, err)
+	}
+
+	return nil
+}
+
+// findInterfacesFile returns the non-test file of the directory declaring all the interfaces.
+func findInterfacesFile(dir string, names []string) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+
+	declared := map[string]string{}
+
+	for _, match := range matches {
+		if strings.HasSuffix(match, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), match, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", fmt.Errorf("parse %s: %w", match, err)
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				declared[spec.(*ast.TypeSpec).Name.Name] = match
+			}
+		}
+	}
+
+	var fp string
+
+	for _, name := range names {
+		file, ok := declared[name]
+		if !ok {
+			return "", fmt.Errorf("interface %s is not declared in %s", name, dir)
+		}
+
+		if fp != "" && fp != file {
+			return "", fmt.Errorf("the interfaces are declared in several files (%s, %s)", filepath.Base(fp), filepath.Base(file))
+		}
+
+		fp = file
+	}
+
+	if fp == "" {
+		return "", errors.New("no interface to mock")
+	}
+
+	return fp, nil
+}
+
+// writeInline appends the generated mocks to the end of the source file, after the delimiter.
+// The mocks previously appended are replaced.
+func writeInline(fp string, generated []byte) error {
+	content, err := os.ReadFile(fp)
+	if err != nil {
+		return err
+	}
+
+	// Removes the previously appended mocks.
+	base, _, previous := bytes.Cut(content, []byte(inlineDelimiter))
+
+	fset := token.NewFileSet()
+
+	baseFile, err := parser.ParseFile(fset, fp, base, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", fp, err)
+	}
+
+	if previous {
+		// The imports of the previous mocks may not be required anymore.
+		for _, spec := range slices.Clone(baseFile.Imports) {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+
+			if !astutil.UsesImport(baseFile, importPath) {
+				astutil.DeleteNamedImport(fset, baseFile, importName(spec), importPath)
+			}
+		}
+	}
+
+	genFile, err := parser.ParseFile(fset, "", generated, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return fmt.Errorf("parse generated mocks: %w", err)
+	}
+
+	// The imports of the mocks are merged with the imports of the source file.
+	bodyStart := genFile.Name.End()
+
+	for _, decl := range genFile.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			break
+		}
+
+		for _, spec := range genDecl.Specs {
+			importSpec := spec.(*ast.ImportSpec)
+			importPath, _ := strconv.Unquote(importSpec.Path.Value)
+
+			astutil.AddNamedImport(fset, baseFile, importName(importSpec), importPath)
+		}
+
+		bodyStart = genDecl.End()
+	}
+
+	buffer := bytes.NewBufferString("")
+
+	err = format.Node(buffer, fset, baseFile)
+	if err != nil {
+		return fmt.Errorf("format %s: %w", fp, err)
+	}
+
+	_, _ = buffer.WriteString("\n" + inlineDelimiter + "\n")
+	_, _ = buffer.Write(generated[fset.Position(bodyStart).Offset:])
+
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("source %s: %w", fp, withSourceContext(buffer.Bytes(), err))
+	}
+
+	return os.WriteFile(fp, source, 0o640)
+}
+
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name == nil {
+		return ""
+	}
+
+	return spec.Name.Name
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const inlineSource = `package a
+
+import "time"
+
+type Coconut interface {
+	Open(d time.Duration) error
+}
+`
+
+func Test_writeInline(t *testing.T) {
+	fp := filepath.Join(t.TempDir(), "a.go")
+
+	err := os.WriteFile(fp, []byte(inlineSource), 0o600)
+	require.NoError(t, err)
+
+	generated := `// Code generated by mocktail; DO NOT EDIT.
+
+package a
+
+import (
+	"testing"
+	"time"
+)
+
+func newCoconutMock(tb testing.TB) time.Duration { return 0 }
+`
+
+	err = writeInline(fp, []byte(generated))
+	require.NoError(t, err)
+
+	expected := `package a
+
+import (
+	"testing"
+	"time"
+)
+
+type Coconut interface {
+	Open(d time.Duration) error
+}
+
+// ----- Mocks generated by mocktail below this line; DO NOT EDIT. -----
+
+func newCoconutMock(tb testing.TB) time.Duration { return 0 }
+`
+
+	content, err := os.ReadFile(fp)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, string(content))
+
+	// Re-running replaces the previous mocks, and removes the imports not used anymore.
+	generated = `// Code generated by mocktail; DO NOT EDIT.
+
+package a
+
+import "context"
+
+func newCoconutMock(ctx context.Context) {}
+`
+
+	err = writeInline(fp, []byte(generated))
+	require.NoError(t, err)
+
+	expected = `package a
+
+import (
+	"context"
+	"time"
+)
+
+type Coconut interface {
+	Open(d time.Duration) error
+}
+
+// ----- Mocks generated by mocktail below this line; DO NOT EDIT. -----
+
+func newCoconutMock(ctx context.Context) {}
+`
+
+	content, err = os.ReadFile(fp)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, string(content))
+}
+
+func Test_findInterfacesFile(t *testing.T) {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "a.go"), []byte(inlineSource), 0o600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "b.go"), []byte("package a\n\ntype Banana interface{ Peel() }\n"), 0o600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "a_test.go"), []byte("package a\n\ntype Cherry interface{ Eat() }\n"), 0o600)
+	require.NoError(t, err)
+
+	fp, err := findInterfacesFile(dir, []string{"Coconut"})
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(dir, "a.go"), fp)
+
+	_, err = findInterfacesFile(dir, []string{"Coconut", "Banana"})
+	require.Error(t, err)
+
+	_, err = findInterfacesFile(dir, []string{"Cherry"})
+	require.Error(t, err)
+}
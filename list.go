@@ -0,0 +1,49 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"text/tabwriter"
+)
+
+// listInterfaces writes the interfaces of the model, one per line, sorted by name:
+// the qualified name of the interface (import/path.Name), the number of methods, and the file referencing the interface.
+func listInterfaces(w io.Writer, root string, model map[string]PackageDesc) error {
+	type entry struct {
+		name    string
+		methods int
+		file    string
+	}
+
+	var entries []entry
+
+	for fp, pkgDesc := range model {
+		file, err := filepath.Rel(root, fp)
+		if err != nil {
+			return err
+		}
+
+		for _, interfaceDesc := range pkgDesc.Interfaces {
+			entries = append(entries, entry{
+				name:    interfaceDesc.PkgPath + "." + interfaceDesc.Name,
+				methods: len(interfaceDesc.Methods),
+				file:    filepath.ToSlash(file),
+			})
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return cmp.Or(cmp.Compare(a.name, b.name), cmp.Compare(a.file, b.file))
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, e := range entries {
+		_, _ = fmt.Fprintf(tw, "%s\t%d method(s)\t%s\n", e.name, e.methods, e.file)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listInterfaces(t *testing.T) {
+	root, err := filepath.Abs("./testdata/src/b")
+	require.NoError(t, err)
+
+	model, err := walk(t.Context(), root, "b", Options{})
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+
+	err = listInterfaces(&buffer, root, model)
+	require.NoError(t, err)
+
+	expected := `b/c.Coconut    13 method(s)  c/mock_test.go
+b/c.Pineapple  4 method(s)   c/mock_test.go
+`
+
+	assert.Equal(t, expected, buffer.String())
+}
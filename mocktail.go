@@ -4,20 +4,30 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"cmp"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"go/format"
+	"go/scanner"
+	"go/token"
 	"go/types"
 	"io/fs"
 	"log"
+	"log/slog"
+	"maps"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
+	"time"
 
+	"github.com/ettle/strcase"
 	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/imports"
 )
 
 const (
@@ -28,8 +38,52 @@ const (
 
 const contextType = "context.Context"
 
+const testifyMockPkg = "github.com/stretchr/testify/mock"
+
+const defaultReceiver = "_m"
+
+const defaultCallSuffix = "Call"
+
+// defaultTestifyAlias is the name of the import of testify mock in the generated files.
+const defaultTestifyAlias = "mock"
+
+// Matching of the function parameters in the On<Method> methods.
+const (
+	funcArgsAnything = "anything" // mock.Anything.
+	funcArgsIgnore   = "ignore"   // mock.Anything, the parameter is omitted from the On<Method> methods.
+	funcArgsName     = "name"     // pointer identity of the function.
+)
+
+// mainPackage is the name of the package of the commands, which cannot be imported.
+const mainPackage = "main"
+
+// mocksSubPackage is the name of the subpackage of the exported mocks, with the mocks-subpkg option.
+const mocksSubPackage = "mocks"
+
+// Types of cgo, which cannot be mocked.
+const (
+	cgoPackage    = "C"       // import path of the pseudo-package of cgo.
+	cgoTypePrefix = "_Ctype_" // prefix of the types declared by the files generated by cgo.
+)
+
+// Package clause of the generated test files.
+const (
+	testPackageSame     = "same"     // package foo.
+	testPackageExternal = "external" // package foo_test, the types of the package are qualified.
+)
+
+// Formatting of the generated files.
+const (
+	formatGofmt     = "gofmt"     // go/format.
+	formatGoimports = "goimports" // golang.org/x/tools/imports, also removes the unused imports.
+	formatNone      = "none"      // raw output of the template.
+)
+
 const commentTagPattern = "// mocktail:"
 
+// sourceContextLines is the number of lines displayed around a syntax error of the generated source.
+const sourceContextLines = 3
+
 // PackageDesc represent a package.
 type PackageDesc struct {
 	Pkg        *types.Package
@@ -37,329 +91,1651 @@ type PackageDesc struct {
 	Interfaces []InterfaceDesc
 }
 
+// Options represent the generation options.
+type Options struct {
+	// Exported generates exported constructors.
+	Exported bool
+	// NoTestTag writes the mocks into a non-test file.
+	NoTestTag bool
+	// MockBase is the type embedded by the mocks (import/path.Type), `mock.Mock` if empty.
+	MockBase string
+	// Ignore contains the glob patterns of the paths to skip during the walk.
+	Ignore []string
+	// Receiver is the receiver name of the mock methods, `_m` if empty.
+	Receiver string
+	// ContextCheck generates helpers to assert that the methods are not called with a done context.
+	ContextCheck bool
+	// SPDX is the SPDX license expression written at the top of the generated files.
+	SPDX string
+	// Banner is the text written as comments after the generated code marker, one comment per line.
+	Banner string
+	// BareConstructor generates a constructor that doesn't require a `testing.TB`.
+	BareConstructor bool
+	// TestifyStyle generates constructors accepting any `mock.TestingT` with a `Cleanup` method, like mockery.
+	TestifyStyle bool
+	// AnonAt contains the positions (file.go:line) of variables typed with an anonymous interface to mock.
+	AnonAt []string
+	// OutDir is the directory, relative to the module root, where the mocks are written in a tree mirroring the packages.
+	OutDir string
+	// MocksSubPkg writes the exported mocks into a `mocks` subpackage of each package, importing the original package.
+	MocksSubPkg bool
+	// Inline appends the mocks to the file declaring the interfaces instead of a separate file.
+	Inline bool
+	// AnyHelpers generates the On<Method>Any helpers, matching any arguments.
+	AnyHelpers bool
+	// CallCounts generates the Assert<Method>CallCount helpers, asserting the number of calls of a method.
+	CallCounts bool
+	// ZeroValues generates the TypedReturnsZero helpers, returning the zero values.
+	ZeroValues bool
+	// Cleanup registers a cleanup function asserting that the methods annotated with `mocktail:must` were called.
+	Cleanup bool
+	// Stringer generates a String method on the mocks, summarizing the expectations and the recorded calls.
+	Stringer bool
+	// Concurrent guards the mocks with a mutex serializing the setup of the expectations and the checks before the calls of the methods.
+	Concurrent bool
+	// ExposeTB stores the testing interface passed to the constructors on the mocks, returned by their TB method.
+	ExposeTB bool
+	// DebugMethods generates methods printing their calls when the MOCKTAIL_DEBUG environment variable is set.
+	DebugMethods bool
+	// OnceByDefault sets the expectations of the On<Method> helpers to match only once, like `.Once()`.
+	OnceByDefault bool
+	// StrictCalls generates methods failing the test, with the method and the arguments, when no expectation matches the call.
+	StrictCalls bool
+	// FuncArgs is the matching of the function parameters in the On<Method> methods (anything, ignore, name), `anything` if empty.
+	FuncArgs string
+	// TestPackage is the package clause of the generated test files (same, external), `same` if empty.
+	TestPackage string
+	// Format is the formatting of the generated files (gofmt, goimports, none), `gofmt` if empty.
+	Format string
+	// NoFormat skips the formatting of the generated files, like the `none` format.
+	NoFormat bool
+	// CallSuffix is the suffix of the call wrapper types, `Call` if empty.
+	CallSuffix string
+	// Methods restricts the On<Method> helpers to these methods (Interface.Method), the other methods of the interface are stubs.
+	// All the methods of an interface without entry are generated.
+	Methods []string
+	// Interfaces restricts the generation to these interfaces (all the interfaces if empty).
+	Interfaces []string
+	// InterfaceCI matches the names of the interface filter case-insensitively.
+	InterfaceCI bool
+	// DeclarationOrder generates the mocks of a file in the order of the declarations of the interfaces,
+	// instead of the order of the comments.
+	DeclarationOrder bool
+	// FailOnEmpty fails the run when no interface is found, instead of generating nothing.
+	FailOnEmpty bool
+	// Export generates the exported mocks of these interfaces into the non-test file, like Exported,
+	// and the mocks of the other interfaces into the test file.
+	Export []string
+	// IncludeTests loads the test files of the packages: the interfaces declared in the test files can be mocked.
+	IncludeTests bool
+	// Pattern is a go/packages pattern (e.g. ./...): all the interfaces of the matching packages are mocked, instead of the ones of the comments.
+	Pattern string
+	// Since restricts the generation to the packages changed since this git ref (e.g. main).
+	Since string
+	// LogJSON logs the significant events (package loaded, interface generated, file written, error) as JSON objects on stderr.
+	LogJSON bool
+	// Prune removes the generated files whose source doesn't reference any interface anymore.
+	Prune bool
+	// Diff prints the unified diff between the generated files and their new content instead of writing the files.
+	Diff bool
+	// KeepGoing continues past the errors of a package, the errors are reported at the end of the run.
+	KeepGoing bool
+	// Incremental skips the generation when the hash stored in the generated file is unchanged.
+	Incremental bool
+	// OutputMap contains the generated files (src=dst, relative to the module root) of the files referencing the interfaces,
+	// instead of the files of the default layout.
+	OutputMap []string
+	// Replace contains the replacements (old/path.Type=new/path.Type) of the type references in the generated code.
+	Replace []string
+	// TestifyAlias is the name of the import of testify mock in the generated files, `mock` if empty.
+	TestifyAlias string
+	// CommentTag is the prefix of the comments used to discover the interfaces, `// mocktail:` if empty.
+	CommentTag string
+}
+
 // InterfaceDesc represent an interface.
 type InterfaceDesc struct {
 	Name       string
+	PkgPath    string // Import path of the package declaring the interface.
 	Methods    []*types.Func
 	TypeParams *types.TypeParamList // Generic type parameters
+	MustCall   []string             // Methods annotated with `mocktail:must`, which must be called by the tests.
+	Position   token.Position       // Position of the declaration of the interface.
 }
 
 func main() {
+	start := time.Now()
+
+	var opts Options
+	var cli cliOptions
+	_ = newFlagSet(&opts, &cli).Parse(os.Args[1:])
+
+	if opts.LogJSON {
+		slog.SetDefault(newJSONLogger(os.Stderr))
+	}
+
+	err := opts.validate()
+	if err != nil {
+		opts.fatalf("options: %v", err)
+	}
+
+	if cli.templateFile != "" && cli.templateDir != "" {
+		opts.fatalf("options: -template and -template-dir are exclusive")
+	}
+
 	ctx := context.Background()
 
+	if cli.timeout > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+
+		// The context lives until the end of the run: it's only canceled by the timeout.
+		time.AfterFunc(cli.timeout, func() { cancel(fmt.Errorf("timeout of %s exceeded", cli.timeout)) })
+	}
+
 	info, err := getModuleInfo(ctx, os.Getenv("MOCKTAIL_TEST_PATH"))
 	if err != nil {
-		log.Fatal("get module path", err)
+		opts.fatalf("get module path: %v", withCause(ctx, err))
 	}
 
-	var exported bool
-	var templateFile string
-	flag.BoolVar(&exported, "e", false, "generate exported mocks")
-	flag.StringVar(&templateFile, "template", "", "path to custom template file (uses embedded template if not specified)")
-	flag.Parse()
-
 	root := info.Dir
 
-	err = os.Chdir(root)
-	if err != nil {
-		log.Fatalf("Chdir: %v", err)
+	// At the root of a workspace, the modules of the workspace are walked.
+	var workspace []modInfo
+	if info.GoWork != "" {
+		if opts.OutDir != "" {
+			opts.fatalf("options: the out-dir option is not supported at the root of a workspace")
+		}
+
+		workspace, err = readWorkspaceModules(info.GoWork)
+		if err != nil {
+			opts.fatalf("workspace: %v", err)
+		}
 	}
 
-	model, err := walk(root, info.Path)
+	err = os.Chdir(root)
 	if err != nil {
-		log.Fatalf("walk: %v", err)
+		opts.fatalf("Chdir: %v", err)
 	}
 
-	if len(model) == 0 {
+	if cli.all {
+		err = runGoGenerateDirectives(ctx, root, opts)
+		if err != nil {
+			opts.fatalf("go:generate: %v", err)
+		}
+
 		return
 	}
 
-	tmpl, err := getTemplate(templateFile)
+	// The errors of the packages, with the keep-going option.
+	var failures []error
+
+	var model map[string]PackageDesc
+	switch {
+	case opts.Pattern != "":
+		model, err = walkPattern(ctx, root, opts)
+	case info.GoWork != "":
+		model, err = walkWorkspace(ctx, workspace, opts)
+	default:
+		model, err = walk(ctx, root, info.Path, opts)
+	}
 	if err != nil {
-		log.Fatalf("parse template: %v", err)
+		if !opts.KeepGoing || model == nil {
+			opts.fatalf("walk: %v", withCause(ctx, err))
+		}
+
+		failures = append(failures, err)
 	}
 
-	err = generate(model, exported, tmpl)
+	err = loadAnonymousInterfaces(ctx, root, opts, model)
 	if err != nil {
-		log.Fatalf("generate: %v", err)
+		opts.fatalf("anonymous interfaces: %v", withCause(ctx, err))
 	}
-}
 
-//nolint:gocognit,gocyclo // The complexity is expected.
-func walk(root, moduleName string) (map[string]PackageDesc, error) {
-	model := make(map[string]PackageDesc)
+	if opts.FailOnEmpty && len(model) == 0 {
+		failures = append(failures, errors.New("no interface found: check the mocktail comments and the -interface, -pattern and -ignore options"))
+		opts.fatalf("fail-on-empty:\n%v", errors.Join(failures...))
+	}
 
-	err := filepath.WalkDir(root, func(fp string, d fs.DirEntry, err error) error {
+	if cli.list {
+		err = listInterfaces(os.Stdout, root, model)
 		if err != nil {
-			return err
+			opts.fatalf("list: %v", err)
 		}
 
-		if d.IsDir() {
-			if d.Name() == "testdata" || d.Name() == "vendor" {
-				return filepath.SkipDir
-			}
-
-			return nil
+		if len(failures) > 0 {
+			opts.fatalf("failures:\n%v", errors.Join(failures...))
 		}
 
-		if d.Name() != srcMockFile {
-			return nil
+		return
+	}
+
+	var counters Summary
+
+	if len(model) > 0 {
+		var tmpl *template.Template
+		if cli.templateDir != "" {
+			tmpl, err = getTemplateDir(cli.templateDir)
+		} else {
+			tmpl, err = getTemplate(cli.templateFile)
+		}
+		if err != nil {
+			opts.fatalf("parse template: %v", err)
 		}
 
-		file, err := os.Open(fp)
+		if info.GoWork != "" {
+			err = generateWorkspace(model, workspace, opts, tmpl, &counters)
+		} else {
+			err = generate(model, root, info.Path, opts, tmpl, &counters)
+		}
 		if err != nil {
-			return err
+			if !opts.KeepGoing {
+				opts.fatalf("generate: %v", err)
+			}
+
+			failures = append(failures, err)
 		}
+	}
 
-		packageDesc := PackageDesc{Imports: map[string]struct{}{}}
+	// The generated files of the failed packages are kept.
+	if opts.Prune && len(failures) == 0 {
+		// Only the walked directories are pruned: the directories of a workspace outside its modules are kept.
+		modules := []modInfo{{Path: info.Path, Dir: root}}
+		if info.GoWork != "" {
+			modules = workspace
+		}
 
-		scanner := bufio.NewScanner(file)
-		for scanner.Scan() {
-			line := scanner.Text()
-			if line == "" {
-				continue
-			}
+		err = prune(modules, model, opts)
+		if err != nil {
+			opts.fatalf("prune: %v", err)
+		}
+	}
 
-			i := strings.Index(line, commentTagPattern)
-			if i <= -1 {
-				continue
-			}
+	if cli.summary {
+		counters.Elapsed = time.Since(start)
 
-			interfaceName := line[i+len(commentTagPattern):]
+		opts.logEvent(eventSummary, fmt.Sprintf("summary: %s", counters),
+			"packages", counters.Packages, "interfaces", counters.Interfaces, "methods", counters.Methods,
+			"files", counters.Files, "elapsed", counters.Elapsed)
+	}
 
-			var importPath string
-			if index := strings.LastIndex(interfaceName, "."); index > 0 {
-				importPath = path.Join(moduleName, interfaceName[:index])
+	if len(failures) > 0 {
+		opts.fatalf("failures:\n%v", errors.Join(failures...))
+	}
+}
 
-				interfaceName = interfaceName[index+1:]
-			} else {
-				filePkgName, err := filepath.Rel(root, filepath.Dir(fp))
-				if err != nil {
-					return err
-				}
+// cliOptions holds the flags of the command that are not options of the generation.
+type cliOptions struct {
+	templateFile string
+	templateDir  string
+	summary      bool
+	all          bool
+	list         bool
+	timeout      time.Duration
+}
 
-				importPath = path.Join(moduleName, filePkgName)
+// newFlagSet returns the flag set of the command, storing the flags into opts and cli.
+func newFlagSet(opts *Options, cli *cliOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	fs.BoolVar(&opts.Exported, "e", false, "generate exported mocks")
+	fs.BoolVar(&opts.NoTestTag, "no-test-tag", false, "generate mocks into a non-test file without exporting them")
+	fs.StringVar(&opts.CommentTag, "comment-tag", commentTagPattern, "prefix of the comments used to discover the interfaces")
+	fs.BoolVar(&opts.IncludeTests, "include-tests", false, "load the test files of the packages, to mock the interfaces declared in the test files")
+	fs.StringVar(&opts.Pattern, "pattern", "", "go/packages pattern (e.g. ./...) of the packages whose interfaces are all mocked, instead of the mocktail comments")
+	fs.StringVar(&opts.Since, "since", "", "only generate the mocks of the packages changed since the git ref (e.g. main)")
+	fs.BoolVar(&opts.LogJSON, "log-json", false, "log the events (package loaded, interface generated, file written, error) as JSON objects on stderr")
+	fs.BoolVar(&opts.Diff, "diff", false, "print the unified diff between the generated files and their new content, without writing the files")
+	fs.BoolVar(&opts.Prune, "prune", false, "remove the generated files whose source doesn't reference any interface anymore")
+	fs.BoolVar(&opts.KeepGoing, "keep-going", false, "continue past the errors of a package, report all the errors at the end of the run")
+	fs.BoolVar(&opts.Incremental, "incremental", false, "skip the packages whose interfaces are unchanged since the last generation")
+	fs.StringVar(&opts.OutDir, "out-dir", "", "directory (relative to the module root) where the mocks are written in a tree mirroring the packages, requires -e")
+	fs.BoolVar(&opts.MocksSubPkg, "mocks-subpkg", false, "write the exported mocks into a mocks subpackage of each package (package mocks), requires -e")
+	fs.BoolVar(&opts.Inline, "inline", false, "append the mocks to the file declaring the interfaces")
+	fs.StringVar(&opts.MockBase, "mock-base", "", "custom type embedded by the mocks (import/path.Type), the type must embed `mock.Mock`")
+	fs.StringVar(&opts.Receiver, "receiver", defaultReceiver, "receiver name of the mock methods")
+	fs.StringVar(&opts.FuncArgs, "func-args", funcArgsAnything, "matching of the function parameters in the On<Method> methods: anything, ignore (omitted from the signature), or name (pointer identity)")
+	fs.StringVar(&opts.TestPackage, "test-package", testPackageSame, "package clause of the generated test files: same (package foo) or external (package foo_test)")
+	fs.StringVar(&opts.Format, "format", formatGofmt, "formatting of the generated files: gofmt, goimports, or none (raw output of the template, to debug a template)")
+	fs.BoolVar(&opts.NoFormat, "no-format", false, "skip the formatting of the generated files (like -format=none), when they are formatted by another step")
+	fs.StringVar(&opts.TestifyAlias, "testify-alias", defaultTestifyAlias, "name of the import of testify mock in the generated files, when the package declares a mock identifier")
+	fs.StringVar(&opts.CallSuffix, "call-suffix", defaultCallSuffix, "suffix of the call wrapper types")
+	fs.BoolVar(&opts.ContextCheck, "with-context-check", false, "generate helpers to assert that the methods are not called with a done context")
+	fs.BoolVar(&opts.AnyHelpers, "with-any-helpers", false, "generate On<Method>Any helpers matching any arguments")
+	fs.BoolVar(&opts.CallCounts, "with-call-counts", false, "generate Assert<Method>CallCount helpers asserting the number of calls of a method")
+	fs.BoolVar(&opts.ZeroValues, "with-zero-values", false, "generate TypedReturnsZero helpers returning the zero values")
+	fs.BoolVar(&opts.Cleanup, "with-cleanup", false, "assert at the end of the tests that the methods annotated with `mocktail:must` were called")
+	fs.BoolVar(&opts.Concurrent, "concurrent", false, "serialize the setup of the expectations and the checks before the calls of the mock methods with a mutex, for the mocks used across goroutines")
+	fs.BoolVar(&opts.ExposeTB, "expose-tb", false, "store the testing.TB passed to the constructors on the mocks, returned by their TB method")
+	fs.BoolVar(&opts.DebugMethods, "debug-methods", false, "generate methods printing their calls (method and arguments) to the DebugWriter of the mock (os.Stderr by default) when MOCKTAIL_DEBUG is set")
+	fs.BoolVar(&opts.OnceByDefault, "once-by-default", false, "set the expectations of the On<Method> helpers to match only once (override with Times or Maybe)")
+	fs.BoolVar(&opts.StrictCalls, "strict-calls", false, "generate methods failing the test with the method and the arguments when no expectation matches the call")
+	fs.BoolVar(&opts.Stringer, "with-stringer", false, "generate a String method on the mocks, summarizing the expectations and the recorded calls")
+	fs.BoolVar(&opts.TestifyStyle, "testify-style", false, "generate constructors accepting any mock.TestingT with a Cleanup method (like mockery)")
+	fs.BoolVar(&opts.BareConstructor, "with-bare-constructor", false, "generate an additional constructor that doesn't require a testing.TB")
+	fs.Func("anon-at", "position (file.go:line) of a variable typed with an anonymous interface to mock (can be repeated)", func(v string) error {
+		opts.AnonAt = append(opts.AnonAt, v)
+		return nil
+	})
+	fs.StringVar(&opts.SPDX, "spdx", "", "SPDX license identifier written at the top of the generated files (e.g. MIT)")
+	fs.StringVar(&opts.Banner, "banner", "", `text written as comments after the generated code marker, \n separates the lines`)
+	fs.StringVar(&cli.templateFile, "template", "", "path to custom template file (uses embedded template if not specified)")
+	fs.StringVar(&cli.templateDir, "template-dir", "", "directory of custom template fragments (*.tmpl), defining together the imports, mockBase, combinedCall and combinedMockMethod templates")
+	fs.Func("methods", "comma-separated methods (Interface.Method) with On<Method> helpers, the other methods of these interfaces are stubs", func(v string) error {
+		for name := range strings.SplitSeq(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				opts.Methods = append(opts.Methods, name)
 			}
+		}
 
-			pkgs, err := packages.Load(
-				&packages.Config{
-					Mode: packages.NeedTypes,
-					Dir:  root,
-				},
-				importPath,
-			)
-			if err != nil {
-				return fmt.Errorf("load package %q: %w", importPath, err)
+		return nil
+	})
+	fs.Func("interface", "comma-separated names of the interfaces to generate, or @file to read the names from a file (one per line)", func(v string) error {
+		names, err := parseInterfaceFilter(v)
+		opts.Interfaces = append(opts.Interfaces, names...)
+		return err
+	})
+	fs.BoolVar(&opts.InterfaceCI, "interface-ci", false, "match the names of -interface case-insensitively")
+	fs.BoolVar(&opts.DeclarationOrder, "declaration-order", false, "generate the mocks of a file in the order of the declarations of the interfaces (package, file, position), instead of the order of the comments")
+	fs.BoolVar(&opts.FailOnEmpty, "fail-on-empty", false, "fail when no interface is found (e.g. a misconfigured -interface or -pattern), instead of generating nothing")
+	fs.Func("export", "comma-separated names of the interfaces whose mocks are exported (like -e), the mocks of the other interfaces are generated into the test file", func(v string) error {
+		for name := range strings.SplitSeq(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				opts.Export = append(opts.Export, name)
 			}
+		}
 
-			// Only one package specified by the import path has been loaded.
-			lookup := pkgs[0].Types.Scope().Lookup(interfaceName)
-			if lookup == nil {
-				log.Printf("Unable to find: %s", interfaceName)
-				continue
-			}
+		return nil
+	})
+	fs.Func("output-map", "generated file of the mocks referenced by a file (path/to/mock_test.go=path/to/mocks.go, relative to the module root) (can be repeated)", func(v string) error {
+		opts.OutputMap = append(opts.OutputMap, v)
+		return nil
+	})
+	fs.Func("replace", "replacement (old/path.Type=new/path.Type) of a type reference in the generated code (can be repeated)", func(v string) error {
+		opts.Replace = append(opts.Replace, v)
+		return nil
+	})
+	fs.Func("ignore", "comma-separated glob patterns of the paths to skip (patterns without slash are matched against the file or directory name)", func(v string) error {
+		opts.Ignore = append(opts.Ignore, strings.Split(v, ",")...)
+		return nil
+	})
+	fs.BoolVar(&cli.summary, "summary", false, "print a summary (packages, interfaces, methods, files, elapsed time) at the end of the run")
+	fs.BoolVar(&cli.list, "list", false, "print the interfaces found under the root (import/path.Name, number of methods, file) without generating")
+	fs.BoolVar(&cli.all, "all", false, "run all the `//go:generate mocktail` directives of the module")
+	fs.DurationVar(&cli.timeout, "timeout", 0, "maximum duration of the run, including the loading of the packages (e.g. 2m), no limit if 0")
 
-			if packageDesc.Pkg == nil {
-				packageDesc.Pkg = lookup.Pkg()
-			}
+	return fs
+}
 
-			interfaceDesc := InterfaceDesc{Name: interfaceName}
+// outputFileName returns the name of the generated file.
+// Exported mocks and mocks without test tag are written into a non-test file.
+func (o Options) outputFileName() string {
+	if o.Exported || o.NoTestTag {
+		return outputExportedMockFile
+	}
 
-			// Check if this is a generic interface
-			if namedType, ok := lookup.Type().(*types.Named); ok {
-				interfaceDesc.TypeParams = namedType.TypeParams()
-			}
+	return outputMockFile
+}
 
-			interfaceType, ok := lookup.Type().Underlying().(*types.Interface)
-			if !ok {
-				return fmt.Errorf("type %q in %q is not an interface", lookup.Type(), fp)
-			}
+// testifyAlias returns the name of the import of testify mock in the generated files.
+func (o Options) testifyAlias() string {
+	if o.TestifyAlias == "" {
+		return defaultTestifyAlias
+	}
 
-			for method := range interfaceType.Methods() {
-				interfaceDesc.Methods = append(interfaceDesc.Methods, method)
+	return o.TestifyAlias
+}
 
-				for _, imp := range getMethodImports(method, packageDesc.Pkg.Path()) {
-					packageDesc.Imports[imp] = struct{}{}
-				}
-			}
+// commentTag returns the prefix of the comments used to discover the interfaces.
+func (o Options) commentTag() string {
+	if strings.TrimSpace(o.CommentTag) == "" {
+		return commentTagPattern
+	}
 
-			packageDesc.Interfaces = append(packageDesc.Interfaces, interfaceDesc)
-		}
+	return o.CommentTag
+}
 
-		if len(packageDesc.Interfaces) > 0 {
-			model[fp] = packageDesc
-		}
+// outputDir returns the directory of the mocks of the package directory:
+// the mocks subpackage with the mocks-subpkg option, otherwise the directory mirrored under the output directory.
+func (o Options) outputDir(root, dir string) (string, error) {
+	if o.MocksSubPkg {
+		return filepath.Join(dir, mocksSubPackage), nil
+	}
 
-		return nil
-	})
+	rel, err := filepath.Rel(root, dir)
 	if err != nil {
-		return nil, fmt.Errorf("walk dir: %w", err)
+		return "", fmt.Errorf("output directory: %w", err)
 	}
 
-	return model, nil
+	return filepath.Join(root, o.OutDir, rel), nil
 }
 
-func getMethodImports(method *types.Func, importPath string) []string {
-	signature := method.Signature()
+// outputFile returns the path of the generated file of the mocks referenced by the file fp:
+// in the directory of fp, or in the output directory of its package.
+func (o Options) outputFile(root, fp string) (string, error) {
+	if out, ok := o.mappedOutputFile(root, fp); ok {
+		return out, nil
+	}
 
-	var imports []string
+	dir := filepath.Dir(fp)
 
-	for _, imp := range getTupleImports(signature.Params(), signature.Results()) {
-		if imp != "" && imp != importPath {
-			imports = append(imports, imp)
+	if o.OutDir != "" || o.MocksSubPkg {
+		var err error
+		dir, err = o.outputDir(root, dir)
+		if err != nil {
+			return "", err
 		}
 	}
 
-	return imports
+	return filepath.Join(dir, o.outputFileName()), nil
 }
 
-func getTupleImports(tuples ...*types.Tuple) []string {
-	var imports []string
-
-	for _, tuple := range tuples {
-		for v := range tuple.Variables() {
-			imports = append(imports, getTypeImports(v.Type())...)
-		}
+// mockBase returns the import path and the name of the type embedded by the mocks.
+func (o Options) mockBase() (string, string) {
+	if o.MockBase == "" {
+		return testifyMockPkg, "Mock"
 	}
 
-	return imports
+	i := strings.LastIndex(o.MockBase, ".")
+
+	return o.MockBase[:i], o.MockBase[i+1:]
 }
 
-func getTypeImports(t types.Type) []string {
-	switch v := t.(type) {
-	case *types.Basic:
-		return []string{""}
+// loadMode returns the mode of the loading of the packages.
+// The packages are type-checked with their imports and their dependencies:
+// without them, the types of the imported packages are missing.
+func loadMode(mode packages.LoadMode) packages.LoadMode {
+	return mode | packages.NeedImports | packages.NeedDeps
+}
 
-	case *types.Slice:
-		return getTypeImports(v.Elem())
+// isIgnored reports whether the path matches one of the ignore patterns.
+// The patterns are matched against the path relative to the root,
+// the patterns without slash are also matched against the file or directory name.
+func (o Options) isIgnored(root, fp string) bool {
+	rel, err := filepath.Rel(root, fp)
+	if err != nil || rel == "." {
+		return false
+	}
 
-	case *types.Array:
-		return getTypeImports(v.Elem())
+	rel = filepath.ToSlash(rel)
 
-	case *types.Struct:
-		var imports []string
-		for f := range v.Fields() {
-			imports = append(imports, getTypeImports(f.Type())...)
+	for _, pattern := range o.Ignore {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
 		}
-		return imports
-
-	case *types.Map:
-		imports := getTypeImports(v.Key())
-		imports = append(imports, getTypeImports(v.Elem())...)
-		return imports
 
-	case *types.Named:
-		if v.Obj().Pkg() == nil {
-			return []string{""}
+		if strings.Contains(pattern, "/") {
+			continue
 		}
 
-		return []string{v.Obj().Pkg().Path()}
+		if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+			return true
+		}
+	}
 
-	case *types.Pointer:
-		return getTypeImports(v.Elem())
+	return false
+}
 
-	case *types.Interface:
-		return []string{""}
+func (o Options) validate() error {
+	if o.SPDX != "" {
+		if err := validateSPDX(o.SPDX); err != nil {
+			return err
+		}
+	}
 
-	case *types.Signature:
-		return getTupleImports(v.Params(), v.Results())
+	if o.Receiver != "" && !token.IsIdentifier(o.Receiver) {
+		return fmt.Errorf("invalid receiver %q: must be a Go identifier", o.Receiver)
+	}
 
-	case *types.Chan:
-		return []string{""}
+	switch o.FuncArgs {
+	case "", funcArgsAnything, funcArgsIgnore, funcArgsName:
+	default:
+		return fmt.Errorf("invalid func args %q: must be %s, %s or %s", o.FuncArgs, funcArgsAnything, funcArgsIgnore, funcArgsName)
+	}
 
-	case *types.TypeParam:
-		return []string{""}
+	switch o.TestPackage {
+	case "", testPackageSame:
+	case testPackageExternal:
+		if o.Exported || o.NoTestTag || o.Inline || o.OutDir != "" || o.MocksSubPkg {
+			return errors.New("the external test package requires a test file: it is not compatible with -e, -no-test-tag, -inline, -out-dir, and -mocks-subpkg")
+		}
+	default:
+		return fmt.Errorf("invalid test package %q: must be %s or %s", o.TestPackage, testPackageSame, testPackageExternal)
+	}
 
+	switch o.Format {
+	case "", formatGofmt, formatGoimports, formatNone:
 	default:
-		panic(fmt.Sprintf("OOPS %[1]T %[1]s", t))
+		return fmt.Errorf("invalid format %q: must be %s, %s or %s", o.Format, formatGofmt, formatGoimports, formatNone)
 	}
-}
 
-func generate(model map[string]PackageDesc, exported bool, tmpl *template.Template) error {
-	for fp, pkgDesc := range model {
-		buffer := bytes.NewBufferString("")
+	if o.NoFormat && o.Format == formatGoimports {
+		return errors.New("-no-format and -format=goimports are exclusive")
+	}
 
-		// Create a Syrup instance with the first method to parse the template once
-		if len(pkgDesc.Interfaces) > 0 && len(pkgDesc.Interfaces[0].Methods) > 0 {
-			firstMethod := pkgDesc.Interfaces[0].Methods[0]
-			templateSyrup := &Syrup{
-				PkgPath:       pkgDesc.Pkg.Path(),
-				InterfaceName: pkgDesc.Interfaces[0].Name,
-				Method:        firstMethod,
-				Signature:     firstMethod.Signature(),
-				TypeParams:    pkgDesc.Interfaces[0].TypeParams,
-				Template:      tmpl,
-			}
+	if o.TestifyAlias != "" && (!token.IsIdentifier(o.TestifyAlias) || o.TestifyAlias == "_") {
+		return fmt.Errorf("invalid testify alias %q: must be a Go identifier", o.TestifyAlias)
+	}
 
-			err := templateSyrup.WriteImports(buffer, pkgDesc)
-			if err != nil {
-				return err
-			}
+	if o.CallSuffix != "" && !token.IsIdentifier(o.CallSuffix) {
+		return fmt.Errorf("invalid call suffix %q: must be a Go identifier", o.CallSuffix)
+	}
+
+	for _, pattern := range o.Ignore {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid ignore pattern %q: %w", pattern, err)
 		}
+	}
 
-		for _, interfaceDesc := range pkgDesc.Interfaces {
-			// Write mock base using the template Syrup (or create one if we don't have one)
-			// Create a Syrup for this interface
-			firstMethod := interfaceDesc.Methods[0]
-			baseSyrup := &Syrup{
-				PkgPath:       pkgDesc.Pkg.Path(),
-				InterfaceName: pkgDesc.Interfaces[0].Name,
-				Method:        firstMethod,
-				Signature:     firstMethod.Signature(),
-				TypeParams:    pkgDesc.Interfaces[0].TypeParams,
-				Template:      tmpl,
-			}
+	if o.MockBase != "" {
+		i := strings.LastIndex(o.MockBase, ".")
+		if i <= strings.LastIndex(o.MockBase, "/")+1 || i == len(o.MockBase)-1 {
+			return fmt.Errorf("invalid mock base %q: the expected format is import/path.Type", o.MockBase)
+		}
+	}
 
-			err := baseSyrup.WriteMockBase(buffer, interfaceDesc, exported)
-			if err != nil {
-				return err
-			}
+	if o.OutDir != "" {
+		if !filepath.IsLocal(o.OutDir) {
+			return fmt.Errorf("invalid output directory %q: must be a path relative to the module root", o.OutDir)
+		}
 
-			_, _ = buffer.WriteString("\n")
+		if !o.Exported || o.Inline {
+			return errors.New("the output directory requires exported mocks (-e), and is not compatible with inline mocks")
+		}
+	}
 
-			for _, method := range interfaceDesc.Methods {
-				syrup := &Syrup{
-					PkgPath:       pkgDesc.Pkg.Path(),
-					InterfaceName: interfaceDesc.Name,
-					Method:        method,
-					Signature:     method.Signature(),
-					TypeParams:    interfaceDesc.TypeParams,
-					Template:      tmpl,
-				}
+	if o.Prune && (o.Since != "" || o.Pattern != "" || len(o.Interfaces) > 0) {
+		return errors.New("the prune option requires a full generation: it is not compatible with -since, -pattern, and -interface")
+	}
 
-				err = syrup.MockMethod(buffer)
-				if err != nil {
-					return err
-				}
+	if o.Diff && (o.Inline || o.Prune) {
+		return errors.New("the diff option doesn't write the files: it is not compatible with -inline and -prune")
+	}
 
-				err = syrup.Call(buffer, interfaceDesc.Methods)
-				if err != nil {
-					return err
-				}
+	if o.Pattern != "" && o.Since != "" {
+		return errors.New("the pattern is not compatible with -since: the pattern selects the packages")
+	}
+
+	if o.MocksSubPkg {
+		if !o.Exported || o.Inline || o.OutDir != "" {
+			return errors.New("the mocks subpackage requires exported mocks (-e), and is not compatible with inline mocks and the output directory")
+		}
+	}
+
+	if len(o.Export) > 0 {
+		if o.Exported || o.NoTestTag || o.Inline || o.OutDir != "" || o.MocksSubPkg || o.TestPackage == testPackageExternal {
+			return errors.New("the export option chooses the exported mocks: it is not compatible with -e, -no-test-tag, -inline, -out-dir, -mocks-subpkg, and the external test package")
+		}
+
+		for _, name := range o.Export {
+			if !token.IsIdentifier(name) {
+				return fmt.Errorf("invalid exported interface %q: must be the name of an interface", name)
 			}
 		}
+	}
+
+	for _, method := range o.Methods {
+		name, methodName, ok := strings.Cut(method, ".")
+		if !ok || !token.IsIdentifier(name) || !token.IsIdentifier(methodName) {
+			return fmt.Errorf("invalid method %q: the expected format is Interface.Method", method)
+		}
+	}
 
-		// gofmt
-		source, err := format.Source(buffer.Bytes())
+	if len(o.OutputMap) > 0 && (o.Inline || o.OutDir != "" || o.MocksSubPkg || len(o.Export) > 0) {
+		return errors.New("the output mapping chooses the generated files: it is not compatible with -inline, -out-dir, -mocks-subpkg, and -export")
+	}
+
+	for _, entry := range o.OutputMap {
+		src, dst, err := parseOutputMapping(entry)
 		if err != nil {
-			log.Println(buffer.String())
-			return fmt.Errorf("source: %w", err)
+			return err
+		}
+
+		// The mocks generated into another directory are used from another package.
+		if filepath.Dir(src) != filepath.Dir(dst) && !o.Exported {
+			return fmt.Errorf("invalid output mapping %q: the mocks generated into another directory require exported mocks (-e)", entry)
+		}
+	}
+
+	for _, entry := range o.Replace {
+		if _, _, err := parseReplacement(entry); err != nil {
+			return err
 		}
+	}
 
-		fileName := outputMockFile
-		if exported {
-			fileName = outputExportedMockFile
+	for _, anchor := range o.AnonAt {
+		if _, _, err := parseAnchor(anchor); err != nil {
+			return err
 		}
+	}
 
-		out := filepath.Join(filepath.Dir(fp), fileName)
+	return nil
+}
+
+//nolint:gocognit,gocyclo // The complexity is expected.
+func walk(ctx context.Context, root, moduleName string, opts Options) (map[string]PackageDesc, error) {
+	model := make(map[string]PackageDesc)
+
+	// The nested modules are resolved independently: their import paths are not relative to the root module.
+	modules := map[string]modInfo{root: {Path: moduleName, Dir: root}}
 
-		log.Println(out)
+	// The errors of the packages, with the keep-going option.
+	var errs []error
 
-		err = os.WriteFile(out, source, 0o640)
+	// The directories changed since the git ref, with the since option.
+	var changed map[string]struct{}
+	if opts.Since != "" {
+		var err error
+		changed, err = getChangedDirs(ctx, root, opts.Since)
 		if err != nil {
-			return fmt.Errorf("write file: %w", err)
+			return nil, err
 		}
 	}
 
-	return nil
+	err := filepath.WalkDir(root, func(fp string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == "testdata" || d.Name() == "vendor" || opts.isIgnored(root, fp) {
+				return filepath.SkipDir
+			}
+
+			goModPath := filepath.Join(fp, "go.mod")
+			if _, err := os.Stat(goModPath); err == nil && fp != root {
+				info, err := readModuleInfo(goModPath)
+				if err != nil {
+					return fmt.Errorf("nested module: %w", err)
+				}
+
+				modules[fp] = info
+			}
+
+			return nil
+		}
+
+		if d.Name() != srcMockFile || opts.isIgnored(root, fp) {
+			return nil
+		}
+
+		module, _ := findModule(modules, filepath.Dir(fp))
+
+		names, err := readInterfaceNames(fp, opts)
+		if err == nil && changed != nil && !isChangedSince(changed, module, fp, names) {
+			return nil
+		}
+
+		var packageDesc PackageDesc
+		if err == nil {
+			packageDesc, err = readPackageDesc(ctx, module, fp, names, opts)
+		}
+
+		if err != nil {
+			if !opts.KeepGoing {
+				return err
+			}
+
+			// The package is skipped, the walk continues.
+			errs = append(errs, fmt.Errorf("%s: %w", relativePath(fp), err))
+
+			return nil
+		}
+
+		if len(packageDesc.Interfaces) > 0 {
+			model[fp] = packageDesc
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk dir: %w", err)
+	}
+
+	return model, errors.Join(errs...)
+}
+
+// readPackageDesc reads the interfaces referenced by the comments of the file.
+func readPackageDesc(ctx context.Context, module modInfo, fp string, names []string, opts Options) (PackageDesc, error) {
+	packageDesc := PackageDesc{Imports: map[string]struct{}{}}
+
+	for _, interfaceName := range names {
+		err := addInterface(ctx, &packageDesc, module.Dir, module.Path, fp, interfaceName, opts)
+		if err != nil {
+			return PackageDesc{}, err
+		}
+	}
+
+	return packageDesc, nil
+}
+
+// readInterfaceNames returns the names of the selected interfaces referenced by the comments of the file.
+func readInterfaceNames(fp string, opts Options) ([]string, error) {
+	file, err := os.Open(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	var names []string
+
+	commentTag := opts.commentTag()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		i := strings.Index(line, commentTag)
+		if i <= -1 {
+			continue
+		}
+
+		// A comment can contain several interfaces separated by commas.
+		// The annotations of the methods of the interfaces declared in the file (e.g. `// mocktail:must`) are not references.
+		for interfaceName := range strings.SplitSeq(line[i+len(commentTag):], ",") {
+			interfaceName = strings.TrimSpace(interfaceName)
+			if interfaceName == "" || interfaceName == mustCallAnnotation || !opts.isInterfaceSelected(interfaceName) {
+				continue
+			}
+
+			names = append(names, interfaceName)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return names, opts.checkInterfaceCollisions(names)
+}
+
+// addInterface adds to the package description the interface referenced by a comment of the file.
+// The interface name can be prefixed by the path of its package relative to the module root (e.g. `b.Carrot`).
+func addInterface(ctx context.Context, packageDesc *PackageDesc, root, moduleName, fp, interfaceName string, opts Options) error {
+	filePkgName, err := filepath.Rel(root, filepath.Dir(fp))
+	if err != nil {
+		return err
+	}
+
+	filePkgPath := path.Join(moduleName, filepath.ToSlash(filePkgName))
+
+	importPath := filePkgPath
+	if index := strings.LastIndex(interfaceName, "."); index > 0 {
+		importPath = path.Join(moduleName, interfaceName[:index])
+
+		interfaceName = interfaceName[index+1:]
+	}
+
+	pkgs, err := packages.Load(
+		&packages.Config{
+			// The syntax is required to type-check the package from the source:
+			// the export data doesn't contain the unexported identifiers, used to detect the name clashes.
+			Context: ctx,
+			Mode:    loadMode(packages.NeedTypes | packages.NeedSyntax | packages.NeedName),
+			Dir:     root,
+			Tests:   opts.IncludeTests,
+		},
+		importPath,
+	)
+	if err != nil {
+		return fmt.Errorf("load package %q: %w", importPath, err)
+	}
+
+	opts.logEvent(eventLoaded, "", "package", importPath)
+
+	pkg := selectPackage(pkgs, importPath)
+
+	lookup := pkg.Types.Scope().Lookup(interfaceName)
+	if lookup == nil {
+		log.Printf("Unable to find: %s", interfaceName)
+		return nil
+	}
+
+	// The identifiers of the test files are only visible from the test files of their package.
+	if filename := pkg.Fset.Position(lookup.Pos()).Filename; strings.HasSuffix(filename, "_test.go") {
+		if importPath != filePkgPath || !strings.HasSuffix(opts.outputFileName(), "_test.go") {
+			return fmt.Errorf("%s: the interface %s is declared in the test file %s, its mocks can only be generated in a test file of its package", fp, interfaceName, filepath.Base(filename))
+		}
+	}
+
+	// A main package cannot be imported: only the mocks of its own interfaces can be generated inside it.
+	if importPath != filePkgPath && lookup.Pkg().Name() == mainPackage {
+		return fmt.Errorf("%s: the interface %s belongs to the main package %q, which cannot be imported", fp, interfaceName, importPath)
+	}
+
+	if packageDesc.Pkg == nil {
+		packageDesc.Pkg = lookup.Pkg()
+	}
+
+	interfaceDesc := InterfaceDesc{Name: interfaceName, PkgPath: lookup.Pkg().Path(), Position: pkg.Fset.Position(lookup.Pos())}
+
+	// Check if this is a generic interface
+	if namedType, ok := lookup.Type().(*types.Named); ok {
+		interfaceDesc.TypeParams = namedType.TypeParams()
+	}
+
+	interfaceType, ok := lookup.Type().Underlying().(*types.Interface)
+	if !ok {
+		return fmt.Errorf("type %q in %q is not an interface", lookup.Type(), fp)
+	}
+
+	// Constraint interfaces (type terms, `comparable`) cannot be implemented by a mock.
+	if !interfaceType.IsMethodSet() {
+		log.Printf("Skipping constraint interface: %s", interfaceName)
+		return nil
+	}
+
+	// The mocks are generated in the package of the file: it must be allowed to import the internal packages.
+	if !isImportAllowed(filePkgPath, importPath) {
+		return fmt.Errorf("%s: the interface %s belongs to the internal package %q, which cannot be imported from %q", fp, interfaceName, importPath, filePkgPath)
+	}
+
+	for method := range interfaceType.Methods() {
+		if name := findCgoType(method.Signature()); name != "" {
+			return fmt.Errorf("%s: the method %s.%s uses the cgo type %s, which cannot be referenced outside of the cgo files", fp, interfaceName, method.Name(), name)
+		}
+
+		interfaceDesc.Methods = append(interfaceDesc.Methods, method)
+
+		for _, imp := range getMethodImports(method, packageDesc.Pkg.Path(), opts.replacements()) {
+			if !isImportAllowed(filePkgPath, imp) {
+				return fmt.Errorf("%s: the method %s.%s uses the internal package %q, which cannot be imported from %q", fp, interfaceName, method.Name(), imp, filePkgPath)
+			}
+
+			packageDesc.Imports[imp] = struct{}{}
+		}
+	}
+
+	interfaceDesc.MustCall = getMustCallMethods(pkg.Syntax, interfaceDesc.Methods, opts.commentTag())
+
+	// The methods are generated in alphabetical order, whatever the declaration order and the embedded interfaces:
+	// go/types already sorts them, the order is enforced to keep the generated files stable.
+	slices.SortStableFunc(interfaceDesc.Methods, func(a, b *types.Func) int { return cmp.Compare(a.Name(), b.Name()) })
+
+	for _, imp := range getConstraintImports(interfaceDesc.TypeParams, packageDesc.Pkg.Path(), opts.replacements()) {
+		packageDesc.Imports[imp] = struct{}{}
+	}
+
+	packageDesc.Interfaces = append(packageDesc.Interfaces, interfaceDesc)
+
+	return nil
+}
+
+func getMethodImports(method *types.Func, importPath string, replace map[string]string) []string {
+	signature := method.Signature()
+
+	var imports []string
+
+	for _, imp := range getTupleImports(replace, signature.Params(), signature.Results()) {
+		if imp != "" && imp != importPath {
+			imports = append(imports, imp)
+		}
+	}
+
+	return imports
+}
+
+// selectPackage returns the package of the import path among the loaded packages.
+// With the test files, the package is loaded several times (`pkg`, `pkg [pkg.test]`, `pkg_test [pkg.test]`, `pkg.test`):
+// the variant including the test files is preferred, it declares the identifiers of all the files.
+func selectPackage(pkgs []*packages.Package, importPath string) *packages.Package {
+	var selected *packages.Package
+
+	for _, pkg := range pkgs {
+		if pkg.PkgPath != importPath {
+			continue
+		}
+
+		if selected == nil || strings.HasSuffix(pkg.ID, ".test]") {
+			selected = pkg
+		}
+	}
+
+	if selected == nil {
+		// Only one package specified by the import path has been loaded.
+		return pkgs[0]
+	}
+
+	return selected
+}
+
+// getConstraintImports returns the imports of the constraints of the type parameters.
+func getConstraintImports(typeParams *types.TypeParamList, importPath string, replace map[string]string) []string {
+	if typeParams == nil {
+		return nil
+	}
+
+	var imports []string
+
+	for tp := range typeParams.TypeParams() {
+		for _, imp := range getTypeImports(tp.Constraint(), replace) {
+			if imp != "" && imp != importPath {
+				imports = append(imports, imp)
+			}
+		}
+	}
+
+	return imports
+}
+
+func getTupleImports(replace map[string]string, tuples ...*types.Tuple) []string {
+	var imports []string
+
+	for _, tuple := range tuples {
+		for v := range tuple.Variables() {
+			imports = append(imports, getTypeImports(v.Type(), replace)...)
+		}
+	}
+
+	return imports
+}
+
+func getTypeImports(t types.Type, replace map[string]string) []string {
+	switch v := t.(type) {
+	case *types.Basic:
+		if v.Kind() == types.UnsafePointer {
+			return []string{"unsafe"}
+		}
+
+		return []string{""}
+
+	case *types.Slice:
+		return getTypeImports(v.Elem(), replace)
+
+	case *types.Array:
+		return getTypeImports(v.Elem(), replace)
+
+	case *types.Struct:
+		var imports []string
+		for f := range v.Fields() {
+			imports = append(imports, getTypeImports(f.Type(), replace)...)
+		}
+		return imports
+
+	case *types.Map:
+		imports := getTypeImports(v.Key(), replace)
+		imports = append(imports, getTypeImports(v.Elem(), replace)...)
+		return imports
+
+	case *types.Named:
+		if v.Obj().Pkg() == nil {
+			return []string{""}
+		}
+
+		imports := []string{getTypeImportPath(v.Obj(), replace)}
+		for arg := range v.TypeArgs().Types() {
+			imports = append(imports, getTypeImports(arg, replace)...)
+		}
+
+		return imports
+
+	case *types.Pointer:
+		return getTypeImports(v.Elem(), replace)
+
+	case *types.Interface:
+		imports := []string{""}
+		for embedded := range v.EmbeddedTypes() {
+			imports = append(imports, getTypeImports(embedded, replace)...)
+		}
+		for method := range v.ExplicitMethods() {
+			imports = append(imports, getTypeImports(method.Type(), replace)...)
+		}
+		return imports
+
+	case *types.Union:
+		var imports []string
+		for i := range v.Len() {
+			imports = append(imports, getTypeImports(v.Term(i).Type(), replace)...)
+		}
+		return imports
+
+	case *types.Signature:
+		return getTupleImports(replace, v.Params(), v.Results())
+
+	case *types.Chan:
+		return getTypeImports(v.Elem(), replace)
+
+	case *types.TypeParam:
+		return []string{""}
+
+	case *types.Alias:
+		// Like in the generated code, only the exported aliases are kept.
+		if v.Obj().Pkg() == nil || !v.Obj().Exported() {
+			return getTypeImports(types.Unalias(v), replace)
+		}
+
+		imports := []string{getTypeImportPath(v.Obj(), replace)}
+		for arg := range v.TypeArgs().Types() {
+			imports = append(imports, getTypeImports(arg, replace)...)
+		}
+
+		return imports
+
+	default:
+		panic(fmt.Sprintf("OOPS %[1]T %[1]s", t))
+	}
+}
+
+// generate writes the mocks of the packages of the model.
+// With the keep-going option, the generation continues past the errors of a package, and the errors are joined.
+func generate(model map[string]PackageDesc, root, moduleName string, opts Options, tmpl *template.Template, summary *Summary) error {
+	var errs []error
+
+	// The packages are generated in a stable order: the logs and the errors are reproducible.
+	for _, fp := range slices.Sorted(maps.Keys(model)) {
+		pkgDesc := model[fp]
+
+		summary.Packages++
+
+		if opts.DeclarationOrder {
+			pkgDesc.Interfaces = slices.Clone(pkgDesc.Interfaces)
+			sortByDeclaration(pkgDesc.Interfaces)
+		}
+
+		for _, file := range splitExported(pkgDesc, opts) {
+			err := generatePackage(fp, file.pkgDesc, root, moduleName, file.opts, tmpl, summary)
+			if err != nil {
+				if !opts.KeepGoing {
+					return err
+				}
+
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// generatePackage writes the mocks of a package, fp is the path of the file referencing the interfaces.
+func generatePackage(fp string, pkgDesc PackageDesc, root, moduleName string, opts Options, tmpl *template.Template, summary *Summary) error {
+	out, err := opts.outputFile(root, fp)
+	if err != nil {
+		return err
+	}
+
+	// The import path of the package of the generated file.
+	pkgPath := pkgDesc.Pkg.Path()
+
+	if opts.OutDir != "" || opts.MocksSubPkg || filepath.Dir(out) != filepath.Dir(fp) {
+		if pkgDesc.Pkg.Name() == mainPackage {
+			return fmt.Errorf("%s: the mocks of the main package %q cannot be generated in another package: it cannot be imported", fp, pkgDesc.Pkg.Path())
+		}
+
+		rel, err := filepath.Rel(root, filepath.Dir(out))
+		if err != nil {
+			return err
+		}
+
+		pkgPath = path.Join(moduleName, filepath.ToSlash(rel))
+	}
+
+	if opts.TestPackage == testPackageExternal {
+		pkgPath = pkgDesc.Pkg.Path() + "_test"
+	}
+
+	// The mocks are generated outside of the package of the interfaces: the types of the package are qualified.
+	if pkgPath != pkgDesc.Pkg.Path() {
+		err := checkExportedTypes(pkgDesc)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fp, err)
+		}
+
+		for _, interfaceDesc := range pkgDesc.Interfaces {
+			for _, method := range interfaceDesc.Methods {
+				for _, imp := range getMethodImports(method, pkgPath, opts.replacements()) {
+					pkgDesc.Imports[imp] = struct{}{}
+				}
+			}
+
+			for _, imp := range getConstraintImports(interfaceDesc.TypeParams, pkgPath, opts.replacements()) {
+				pkgDesc.Imports[imp] = struct{}{}
+			}
+		}
+	}
+
+	// The exported mocks are used from the other packages, where they cannot substitute the interfaces with unexported methods.
+	if opts.Exported && pkgPath == pkgDesc.Pkg.Path() {
+		for _, interfaceDesc := range pkgDesc.Interfaces {
+			if name := findUnexportedMethod(interfaceDesc); name != "" {
+				log.Printf("%s: the interface %s has the unexported method %s, its exported mock can only substitute the interface inside its package",
+					relativePath(out), interfaceDesc.Name, name)
+			}
+		}
+	}
+
+	if opts.Incremental && !opts.Inline && readHash(out) == interfacesHash(pkgDesc, opts, tmpl) {
+		opts.logEvent(eventUnchanged, fmt.Sprintf("%s: up to date", relativePath(out)), "file", relativePath(out))
+		return nil
+	}
+
+	buffer := bytes.NewBufferString("")
+
+	// The imports required by the template: the imports of the interfaces are aliased when their names clash.
+	required := []string{"time", testifyMockPkg}
+
+	if importPath, _ := opts.mockBase(); importPath != pkgPath {
+		required = append(required, importPath)
+	}
+
+	// require by the constructor (`testing.TB`) and the context check helpers (without -expose-tb), even outside of test files
+	if !opts.TestifyStyle || opts.ContextCheck && !opts.ExposeTB {
+		required = append(required, "testing")
+	}
+
+	// the String method is not generated on the mocks of the interfaces declaring it
+	stringer := opts.Stringer && slices.ContainsFunc(pkgDesc.Interfaces, func(desc InterfaceDesc) bool { return !hasMethod(desc, "String") })
+
+	if opts.ContextCheck || opts.Concurrent || opts.StrictCalls || stringer {
+		required = append(required, "sync")
+	}
+
+	if opts.StrictCalls || stringer {
+		required = append(required, "fmt")
+	}
+
+	if opts.DebugMethods {
+		required = append(required, "fmt", "io", "os", "strings")
+	}
+
+	if opts.FuncArgs == funcArgsName && hasFuncParams(pkgDesc, opts) {
+		required = append(required, "reflect")
+	}
+
+	for _, imp := range required {
+		pkgDesc.Imports[imp] = struct{}{}
+	}
+
+	// The imports from the directory of the file are checked while walking.
+	if opts.OutDir != "" || opts.MocksSubPkg || filepath.Dir(out) != filepath.Dir(fp) {
+		err := checkInternalImports(pkgDesc, pkgPath)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fp, err)
+		}
+	}
+
+	err = checkGeneratedTypeNames(pkgDesc, opts)
+	if err != nil {
+		return fmt.Errorf("%s: %w", fp, err)
+	}
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		err = opts.checkMethodFilter(interfaceDesc)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fp, err)
+		}
+	}
+
+	aliases := getImportAliases(pkgDesc, opts, required)
+	importNames := getImportNames(pkgDesc, aliases)
+
+	// Create a Syrup instance with the first method to parse the template once
+	if len(pkgDesc.Interfaces) > 0 && len(pkgDesc.Interfaces[0].Methods) > 0 {
+		firstMethod := pkgDesc.Interfaces[0].Methods[0]
+		templateSyrup := &Syrup{
+			PkgPath:       pkgPath,
+			InterfaceName: pkgDesc.Interfaces[0].Name,
+			Method:        firstMethod,
+			Signature:     firstMethod.Signature(),
+			TypeParams:    pkgDesc.Interfaces[0].TypeParams,
+			Template:      tmpl,
+			Receiver:      opts.Receiver,
+			Aliases:       aliases,
+			TestifyAlias:  opts.TestifyAlias,
+			CallSuffix:    opts.CallSuffix,
+			Replace:       opts.replacements(),
+		}
+
+		err := templateSyrup.WriteImports(buffer, pkgDesc, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		// Write mock base using the template Syrup (or create one if we don't have one)
+		// Create a Syrup for this interface
+		firstMethod := interfaceDesc.Methods[0]
+		baseSyrup := &Syrup{
+			PkgPath:       pkgPath,
+			InterfaceName: interfaceDesc.Name,
+			Method:        firstMethod,
+			Signature:     firstMethod.Signature(),
+			TypeParams:    interfaceDesc.TypeParams,
+			Template:      tmpl,
+			Receiver:      opts.Receiver,
+			Aliases:       aliases,
+			TestifyAlias:  opts.TestifyAlias,
+			CallSuffix:    opts.CallSuffix,
+			Replace:       opts.replacements(),
+		}
+
+		if opts.Stringer && hasMethod(interfaceDesc, "String") {
+			log.Printf("%s: the interface %s already declares String, skipping the stringer", relativePath(out), interfaceDesc.Name)
+		}
+
+		if opts.ExposeTB && (hasMethod(interfaceDesc, "TB") || hasMethod(interfaceDesc, "_tb")) {
+			return fmt.Errorf("%s: the interface %s declares TB or _tb, which are the testing interface accessor and field of the mock", relativePath(out), interfaceDesc.Name)
+		}
+
+		if opts.DebugMethods && hasMethod(interfaceDesc, "DebugWriter") {
+			return fmt.Errorf("%s: the interface %s declares DebugWriter, which is the debug field of the mock", relativePath(out), interfaceDesc.Name)
+		}
+
+		err := baseSyrup.WriteMockBase(buffer, interfaceDesc, opts)
+		if err != nil {
+			return err
+		}
+
+		_, _ = buffer.WriteString("\n")
+
+		var selected []*types.Func
+		for _, method := range interfaceDesc.Methods {
+			if opts.isMethodSelected(interfaceDesc.Name, method.Name()) {
+				selected = append(selected, method)
+			}
+		}
+
+		for _, method := range interfaceDesc.Methods {
+			stub := !slices.Contains(selected, method)
+
+			syrup := &Syrup{
+				PkgPath:       pkgPath,
+				InterfaceName: interfaceDesc.Name,
+				Method:        method,
+				Signature:     method.Signature(),
+				TypeParams:    interfaceDesc.TypeParams,
+				Template:      tmpl,
+				Receiver:      opts.Receiver,
+				Aliases:       aliases,
+				TestifyAlias:  opts.TestifyAlias,
+				CallSuffix:    opts.CallSuffix,
+				Replace:       opts.replacements(),
+				ContextCheck:  opts.ContextCheck,
+				AnyHelpers:    opts.AnyHelpers,
+				CallCounts:    opts.CallCounts,
+				ZeroValues:    opts.ZeroValues,
+				FuncArgs:      opts.FuncArgs,
+				Stub:          stub,
+				StrictCalls:   opts.StrictCalls,
+				Concurrent:    opts.Concurrent,
+				OnceByDefault: opts.OnceByDefault,
+				DebugMethods:  opts.DebugMethods,
+				Stringer:      opts.Stringer && !hasMethod(interfaceDesc, "String"),
+				ExposeTB:      opts.ExposeTB,
+				ImportNames:   importNames,
+			}
+
+			err = syrup.MockMethod(buffer)
+			if err != nil {
+				return err
+			}
+
+			// The stubs have no call wrapper.
+			if stub {
+				continue
+			}
+
+			err = syrup.Call(buffer, selected)
+			if err != nil {
+				return err
+			}
+		}
+
+		opts.logEvent(eventGenerated, "", "package", pkgPath, "interface", interfaceDesc.Name, "methods", len(interfaceDesc.Methods))
+	}
+
+	source, err := formatSource(out, buffer.Bytes(), opts.formatting())
+	if err != nil {
+		return fmt.Errorf("source %s: %w", out, withSourceContext(buffer.Bytes(), err))
+	}
+
+	if opts.Inline {
+		err = generateInline(fp, pkgDesc, source, opts)
+		if err != nil {
+			return err
+		}
+
+		summary.addFile(pkgDesc)
+
+		return nil
+	}
+
+	if opts.Diff {
+		return writeDiff(os.Stdout, out, source)
+	}
+
+	opts.logEvent(eventWritten, relativePath(out), "file", relativePath(out), "interfaces", len(pkgDesc.Interfaces))
+
+	err = os.MkdirAll(filepath.Dir(out), 0o750)
+	if err != nil {
+		return fmt.Errorf("create output directory: %w", err)
+	}
+
+	err = os.WriteFile(out, source, 0o640)
+	if err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+
+	summary.addFile(pkgDesc)
+
+	return nil
+}
+
+// checkGeneratedTypeNames checks that the names of the generated types don't clash
+// with each other or with the identifiers of the package.
+func checkGeneratedTypeNames(pkgDesc PackageDesc, opts Options) error {
+	suffix := opts.CallSuffix
+	if suffix == "" {
+		suffix = defaultCallSuffix
+	}
+
+	names := map[string]string{}
+
+	check := func(name, origin string) error {
+		if other, ok := names[name]; ok {
+			return fmt.Errorf("the type %s is generated for both %s and %s, use -call-suffix to change the name of the call types", name, other, origin)
+		}
+
+		names[name] = origin
+
+		if pkgDesc.Pkg == nil {
+			return nil
+		}
+
+		obj := pkgDesc.Pkg.Scope().Lookup(name)
+		if obj != nil && !isGeneratedType(obj, opts) {
+			return fmt.Errorf("the type %s generated for %s clashes with %s, use -call-suffix to change the name of the call types", name, origin, obj)
+		}
+
+		return nil
+	}
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		err := check(strcase.ToGoCamel(interfaceDesc.Name)+"Mock", interfaceDesc.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, method := range interfaceDesc.Methods {
+			err = check(getCallTypeName(interfaceDesc.Name, method.Name(), suffix), interfaceDesc.Name+"."+method.Name())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isGeneratedType reports whether the object is a type previously generated by mocktail (i.e. embedding a testify type).
+func isGeneratedType(obj types.Object, opts Options) bool {
+	if _, ok := obj.(*types.TypeName); !ok {
+		return false
+	}
+
+	st, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	mockBasePath, _ := opts.mockBase()
+
+	for field := range st.Fields() {
+		if !field.Embedded() {
+			continue
+		}
+
+		ft := field.Type()
+		if ptr, ok := ft.(*types.Pointer); ok {
+			ft = ptr.Elem()
+		}
+
+		named, ok := ft.(*types.Named)
+		if !ok || named.Obj().Pkg() == nil {
+			continue
+		}
+
+		if p := named.Obj().Pkg().Path(); p == testifyMockPkg || p == mockBasePath {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasFuncParams reports whether a method of the interfaces, selected by the method filter, has a function parameter.
+func hasFuncParams(pkgDesc PackageDesc, opts Options) bool {
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		for _, method := range interfaceDesc.Methods {
+			if !opts.isMethodSelected(interfaceDesc.Name, method.Name()) {
+				continue
+			}
+
+			for param := range method.Signature().Params().Variables() {
+				if _, ok := param.Type().(*types.Signature); ok {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// withCause adds the cause of the cancellation of the context (e.g. the timeout) to the error.
+func withCause(ctx context.Context, err error) error {
+	if cause := context.Cause(ctx); cause != nil && !errors.Is(err, cause) {
+		return fmt.Errorf("%w: %w", err, cause)
+	}
+
+	return err
+}
+
+// checkInternalImports checks that the generated file can import the internal packages used by the mocks.
+// The go command rejects the files importing an internal package from outside of its parent tree.
+func checkInternalImports(pkgDesc PackageDesc, pkgPath string) error {
+	for _, imp := range slices.Sorted(maps.Keys(pkgDesc.Imports)) {
+		if !isImportAllowed(pkgPath, imp) {
+			return fmt.Errorf("the mocks of the package %q cannot import the internal package %q", pkgPath, imp)
+		}
+	}
+
+	return nil
+}
+
+// isImportAllowed reports whether the package importer is allowed to import the package imported:
+// a package `a/b/internal/c` can only be imported by the packages of the tree rooted at `a/b`.
+func isImportAllowed(importer, imported string) bool {
+	var parent string
+
+	// The last internal element of the import path is the restricting one.
+	switch {
+	case strings.HasSuffix(imported, "/internal"):
+		parent = strings.TrimSuffix(imported, "/internal")
+	case strings.Contains(imported, "/internal/"):
+		parent = imported[:strings.LastIndex(imported, "/internal/")]
+	default:
+		// The top-level internal packages (e.g. `internal/cpu` of the standard library) are not restricted here.
+		return true
+	}
+
+	return importer == parent || strings.HasPrefix(importer, parent+"/")
+}
+
+// checkExportedTypes returns an error if a method of the interfaces uses an unexported type of the package:
+// the type cannot be referenced by mocks generated outside of the package.
+func checkExportedTypes(pkgDesc PackageDesc) error {
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		if name := findUnexportedMethod(interfaceDesc); name != "" {
+			return fmt.Errorf("the method %s.%s is unexported: the mock cannot implement the interface outside of its package", interfaceDesc.Name, name)
+		}
+
+		for _, method := range interfaceDesc.Methods {
+			if name := findUnexportedType(method.Signature(), pkgDesc.Pkg); name != "" {
+				return fmt.Errorf("the method %s.%s uses the unexported type %s", interfaceDesc.Name, method.Name(), name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findUnexportedMethod returns the name of the first unexported method of the interface, or an empty string.
+func findUnexportedMethod(interfaceDesc InterfaceDesc) string {
+	for _, method := range interfaceDesc.Methods {
+		if !method.Exported() {
+			return method.Name()
+		}
+	}
+
+	return ""
+}
+
+// findUnexportedType returns the name of the first unexported type of the package used by the type, or an empty string.
+func findUnexportedType(t types.Type, pkg *types.Package) string {
+	return findNamedType(t, func(obj *types.TypeName) bool { return obj.Pkg() == pkg && !obj.Exported() })
+}
+
+// findCgoType returns the name of the first cgo type (e.g. `C.int`) used by the type, or an empty string.
+// The cgo types are declared by the files generated by cgo (`_Ctype_int`), or by the fake package "C" without cgo processing.
+func findCgoType(t types.Type) string {
+	name := findNamedType(t, func(obj *types.TypeName) bool {
+		return obj.Pkg() != nil && (obj.Pkg().Path() == cgoPackage || strings.HasPrefix(obj.Name(), cgoTypePrefix))
+	})
+	if name == "" {
+		return ""
+	}
+
+	return cgoPackage + "." + strings.TrimPrefix(name, cgoTypePrefix)
+}
+
+// findNamedType returns the name of the first named type matching the predicate used by the type, or an empty string.
+// The exported aliases are kept as written: only their type arguments are inspected.
+func findNamedType(t types.Type, match func(obj *types.TypeName) bool) string {
+	var elems []types.Type
+
+	switch v := t.(type) {
+	case *types.Named:
+		if match(v.Obj()) {
+			return v.Obj().Name()
+		}
+
+		elems = slices.Collect(v.TypeArgs().Types())
+	case *types.Pointer:
+		elems = append(elems, v.Elem())
+	case *types.Slice:
+		elems = append(elems, v.Elem())
+	case *types.Array:
+		elems = append(elems, v.Elem())
+	case *types.Chan:
+		elems = append(elems, v.Elem())
+	case *types.Map:
+		elems = append(elems, v.Key(), v.Elem())
+	case *types.Signature:
+		for param := range v.Params().Variables() {
+			elems = append(elems, param.Type())
+		}
+
+		for result := range v.Results().Variables() {
+			elems = append(elems, result.Type())
+		}
+	case *types.Struct:
+		for field := range v.Fields() {
+			elems = append(elems, field.Type())
+		}
+	case *types.Interface:
+		elems = slices.Collect(v.EmbeddedTypes())
+		for method := range v.ExplicitMethods() {
+			elems = append(elems, method.Type())
+		}
+	case *types.Alias:
+		if v.Obj().Exported() {
+			elems = slices.Collect(v.TypeArgs().Types())
+		} else {
+			elems = append(elems, types.Unalias(v))
+		}
+	}
+
+	for _, elem := range elems {
+		if name := findNamedType(elem, match); name != "" {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// hasMethod reports whether the interface declares a method with this name.
+func hasMethod(interfaceDesc InterfaceDesc, name string) bool {
+	return slices.ContainsFunc(interfaceDesc.Methods, func(method *types.Func) bool { return method.Name() == name })
+}
+
+// relativePath returns the path relative to the current directory (i.e. the module root) to keep the logs concise.
+func relativePath(fp string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return fp
+	}
+
+	rel, err := filepath.Rel(wd, fp)
+	if err != nil {
+		return fp
+	}
+
+	return rel
+}
+
+// formatting returns the formatting of the generated files.
+func (o Options) formatting() string {
+	if o.NoFormat {
+		return formatNone
+	}
+
+	return o.Format
+}
+
+// formatSource formats the generated source of the file.
+func formatSource(fp string, src []byte, formatting string) ([]byte, error) {
+	switch formatting {
+	case formatNone:
+		return src, nil
+
+	case formatGoimports:
+		return imports.Process(fp, src, &imports.Options{Comments: true, TabIndent: true, TabWidth: 8})
+
+	default:
+		return format.Source(src)
+	}
+}
+
+// withSourceContext adds to the error the lines of the source around the position of the error.
+func withSourceContext(src []byte, err error) error {
+	var list scanner.ErrorList
+	if !errors.As(err, &list) || len(list) == 0 {
+		return err
+	}
+
+	lines := strings.Split(string(src), "\n")
+
+	line := list[0].Pos.Line
+	if line < 1 || line > len(lines) {
+		return err
+	}
+
+	b := &strings.Builder{}
+
+	for i := max(line-sourceContextLines, 1); i <= min(line+sourceContextLines, len(lines)); i++ {
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+
+		_, _ = fmt.Fprintf(b, "%s %5d | %s\n", marker, i, lines[i-1])
+	}
+
+	return fmt.Errorf("%w\n%s", err, b.String())
 }
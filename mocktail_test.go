@@ -1,15 +1,23 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"go/format"
+	"go/token"
+	"go/types"
 	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
 )
 
 func TestMocktail(t *testing.T) {
@@ -71,6 +79,40 @@ func TestMocktail(t *testing.T) {
 	}
 }
 
+// TestMocktail_importClashes generates the mocks of interfaces using packages named like the imports of the template.
+func TestMocktail_importClashes(t *testing.T) {
+	const testRoot = "./testdata/clash"
+
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	t.Setenv("MOCKTAIL_TEST_PATH", testRoot)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-debug-methods", "-func-args=name").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	for _, dir := range []string{"d", "r"} {
+		generated, err := os.ReadFile(filepath.Join(testRoot, dir, outputMockFile))
+		require.NoError(t, err)
+
+		golden, err := os.ReadFile(filepath.Join(testRoot, dir, outputMockFile+".golden"))
+		require.NoError(t, err)
+
+		assert.Equal(t, string(golden), string(generated))
+	}
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-v", "./...")
+	cmd.Dir = testRoot
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+}
+
 func TestMocktail_exported(t *testing.T) {
 	const testRoot = "./testdata/exported"
 
@@ -129,3 +171,1708 @@ func TestMocktail_exported(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestMocktail_outDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/exported/b"))
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-e", "-out-dir=internal/mocks").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	genBytes, err := os.ReadFile(filepath.Join(root, "internal", "mocks", "c", outputExportedMockFile))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(genBytes), "func (_m *pineappleMock) Coo(_ context.Context, bParam string, cParam c.Water) c.Water {")
+
+	cmd := exec.CommandContext(t.Context(), "go", "vet", "./internal/...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+}
+
+func TestMocktail_mocksSubPkg(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/exported/b"))
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-e", "-mocks-subpkg").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	genBytes, err := os.ReadFile(filepath.Join(root, "c", mocksSubPackage, outputExportedMockFile))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(genBytes), "package mocks\n")
+	assert.Contains(t, string(genBytes), "func (_m *pineappleMock) Coo(_ context.Context, bParam string, cParam c.Water) c.Water {")
+
+	cmd := exec.CommandContext(t.Context(), "go", "vet", "./c/mocks/...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+}
+
+func TestMocktail_outputMap(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/exported/b"))
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-e", "-output-map=c/mock_test.go=mocks/c/fruits.go").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	genBytes, err := os.ReadFile(filepath.Join(root, "mocks", "c", "fruits.go"))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(genBytes), "package c\n")
+	assert.Contains(t, string(genBytes), "func (_m *pineappleMock) Coo(_ context.Context, bParam string, cParam c.Water) c.Water {")
+
+	cmd := exec.CommandContext(t.Context(), "go", "vet", "./mocks/...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+}
+
+func TestMocktail_externalTestPackage(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	// The tests use the mocks from the external test package.
+	testFile := filepath.Join(root, "c", srcMockFile)
+
+	content, err := os.ReadFile(testFile)
+	require.NoError(t, err)
+
+	content = bytes.Replace(content, []byte("package c\n\nimport (\n"), []byte("package c_test\n\nimport (\n\t. \"b/c\"\n"), 1)
+
+	err = os.WriteFile(testFile, content, 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-test-package=external").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	genBytes, err := os.ReadFile(filepath.Join(root, "c", outputMockFile))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(genBytes), "package c_test\n")
+	assert.Contains(t, string(genBytes), "func (_m *pineappleMock) Coo(_ context.Context, bParam string, cParam c.Water) c.Water {")
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+}
+
+func TestMocktail_callCounts(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	countTest := `package c
+
+import "testing"
+
+func TestCallCount(t *testing.T) {
+	m := newPineappleMock(t).
+		OnWorld().TypedReturns("a").Twice().
+		Parent
+
+	m.World()
+	m.World()
+
+	m.AssertWorldCallCount(t, 2)
+	m.AssertHelloCallCount(t, 0)
+}
+`
+
+	err = os.WriteFile(filepath.Join(root, "c", "count_test.go"), []byte(countTest), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-with-call-counts").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-run", "TestCallCount", "-v", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(output), "--- PASS: TestCallCount")
+}
+
+func TestMocktail_zeroValues(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	zeroTest := `package c
+
+import "testing"
+
+func TestZeroValues(t *testing.T) {
+	m := newPineappleMock(t).
+		OnGoo().TypedReturnsZero().Once().
+		Parent
+
+	s, i, w := m.Goo()
+	if s != "" || i != 0 || w != (Water{}) {
+		t.Fatalf("unexpected values: %q, %d, %v", s, i, w)
+	}
+}
+`
+
+	err = os.WriteFile(filepath.Join(root, "c", "zero_test.go"), []byte(zeroTest), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-with-zero-values").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-run", "TestZeroValues", "-v", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(output), "--- PASS: TestZeroValues")
+}
+
+func TestMocktail_concurrent(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	concurrentTest := `package c
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrent(t *testing.T) {
+	m := newPineappleMock(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			m.OnWorld().TypedReturns("hello").Once()
+		}()
+	}
+
+	wg.Wait()
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if s := m.World(); s != "hello" {
+				t.Errorf("unexpected value: %q", s)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestReentrant(t *testing.T) {
+	m := newPineappleMock(t)
+
+	m.OnWorld().TypedReturns("world").Once()
+
+	// The callback calls the mock: the mutex of the mock is not held during the call.
+	m.OnHello(Water{}).TypedReturns("hello").TypedRun(func(Water) {
+		if s := m.World(); s != "world" {
+			t.Errorf("unexpected value: %q", s)
+		}
+	}).Once()
+
+	if s := m.Hello(Water{}); s != "hello" {
+		t.Errorf("unexpected value: %q", s)
+	}
+}
+`
+
+	err = os.WriteFile(filepath.Join(root, "c", "concurrent_test.go"), []byte(concurrentTest), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-concurrent", "-strict-calls").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	// A deadlock fails the test instead of blocking it.
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-run", "TestConcurrent|TestReentrant", "-timeout", "1m", "-v", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(output), "--- PASS: TestConcurrent")
+	assert.Contains(t, string(output), "--- PASS: TestReentrant")
+}
+
+func TestMocktail_stringer(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	stringerTest := `package c
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStringer(t *testing.T) {
+	m := newPineappleMock(t)
+
+	m.OnHello(Water{}).TypedReturns("a").Once()
+
+	if s := m.String(); !strings.HasPrefix(s, "pineappleMock: 1 expected call(s), 0 recorded call(s)\n\texpected: Hello[") {
+		t.Errorf("unexpected summary: %q", s)
+	}
+
+	m.Hello(Water{})
+
+	if s := m.String(); !strings.HasPrefix(s, "pineappleMock: 1 expected call(s), 1 recorded call(s)") || !strings.Contains(s, "\n\tcalled: Hello[") {
+		t.Errorf("unexpected summary: %q", s)
+	}
+}
+`
+
+	err = os.WriteFile(filepath.Join(root, "c", "stringer_test.go"), []byte(stringerTest), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-with-stringer").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-run", "TestStringer", "-v", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(output), "--- PASS: TestStringer")
+}
+
+func TestMocktail_onceByDefault(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	onceTest := `package c
+
+import "testing"
+
+func TestOnceByDefault(t *testing.T) {
+	m := newPineappleMock(t).
+		OnWorld().TypedReturns("a").
+		OnWorld().TypedReturns("b").
+		OnHello(Water{}).TypedReturns("c").Times(2).
+		Parent
+
+	if s := m.World() + m.World(); s != "ab" {
+		t.Fatalf("unexpected values: %q", s)
+	}
+
+	if s := m.Hello(Water{}) + m.Hello(Water{}); s != "cc" {
+		t.Fatalf("unexpected values: %q", s)
+	}
+}
+`
+
+	err = os.WriteFile(filepath.Join(root, "c", "once_test.go"), []byte(onceTest), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-once-by-default").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-run", "TestOnceByDefault", "-v", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(output), "--- PASS: TestOnceByDefault")
+}
+
+func TestMocktail_exposeTB(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	tbTest := `package c
+
+import (
+	"context"
+	"testing"
+)
+
+func assertHelloOnce(m *pineappleMock) bool {
+	return m.AssertHelloCallCount(1) && m.AssertCooCallCount(0)
+}
+
+func TestExposeTB(t *testing.T) {
+	m := newPineappleMock(t).
+		OnHello(Water{}).TypedReturns("a").Once().
+		OnCoo("", Water{}).TypedReturns(Water{}).Maybe().
+		Parent
+
+	if m.TB() != t {
+		t.Fatal("unexpected testing interface")
+	}
+
+	m.Hello(Water{})
+
+	if !assertHelloOnce(m) {
+		t.Fatal("unexpected calls")
+	}
+
+	m.Coo(context.Background(), "", Water{})
+
+	if !m.AssertCooContextLive() {
+		t.Fatal("unexpected done context")
+	}
+}
+`
+
+	err = os.WriteFile(filepath.Join(root, "c", "tb_test.go"), []byte(tbTest), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-expose-tb", "-with-call-counts", "-with-context-check").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-run", "TestExposeTB", "-v", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(output), "--- PASS: TestExposeTB")
+}
+
+func TestMocktail_templateDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	// The embedded template split into a fragment per template, before the comment of each template.
+	embedded, err := os.ReadFile("templates.go.tmpl")
+	require.NoError(t, err)
+
+	templateDir := t.TempDir()
+
+	for i, fragment := range bytes.Split(embedded, []byte("\n{{/*")) {
+		if i > 0 {
+			fragment = append([]byte("{{/*"), fragment...)
+		}
+
+		err = os.WriteFile(filepath.Join(templateDir, "fragment"+strconv.Itoa(i)+".tmpl"), fragment, 0o600)
+		require.NoError(t, err)
+	}
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-template-dir", templateDir).CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	generated, err := os.ReadFile(filepath.Join(root, "c", outputMockFile))
+	require.NoError(t, err)
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "src", "b", "c", outputMockFile+".golden"))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(golden), string(generated))
+}
+
+func TestMocktail_failOnEmpty(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-interface=Nope").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	output, err = exec.CommandContext(t.Context(), "go", "run", ".", "-fail-on-empty", "-interface=Nope").CombinedOutput()
+	t.Log(string(output))
+
+	require.Error(t, err)
+	assert.Contains(t, string(output), "no interface found")
+
+	output, err = exec.CommandContext(t.Context(), "go", "run", ".", "-fail-on-empty", "-interface=Pineapple").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+}
+
+func TestMocktail_debugMethods(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	debugTest := `package c
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDebugMethods(t *testing.T) {
+	var buffer bytes.Buffer
+
+	m := newPineappleMock(t).OnHello(Water{}).TypedReturns("a").Parent
+	m.DebugWriter = &buffer
+
+	t.Setenv("MOCKTAIL_DEBUG", "")
+	m.Hello(Water{})
+
+	if buffer.Len() != 0 {
+		t.Fatalf("unexpected output: %q", buffer.String())
+	}
+
+	t.Setenv("MOCKTAIL_DEBUG", "1")
+	m.Hello(Water{})
+
+	if s := buffer.String(); s != "pineappleMock.Hello(c.Water{})\n" {
+		t.Fatalf("unexpected output: %q", s)
+	}
+}
+`
+
+	err = os.WriteFile(filepath.Join(root, "c", "debug_test.go"), []byte(debugTest), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-debug-methods").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-run", "TestDebugMethods", "-v", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(output), "--- PASS: TestDebugMethods")
+}
+
+func TestMocktail_testifyAlias(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	// The identifier mock of the package clashes with the import of testify mock.
+	err = os.WriteFile(filepath.Join(root, "c", "mock.go"), []byte("package c\n\nfunc mock() string { return \"mock\" }\n"), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-testify-alias=tmock").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(root, "c", outputMockFile))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), `tmock "github.com/stretchr/testify/mock"`)
+	assert.Contains(t, string(content), "type pineappleMock struct{ tmock.Mock }")
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+}
+
+func TestMocktail_export(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	// The mock of Pineapple is exported, the mock of Coconut is only available to the tests.
+	srcFile := filepath.Join(root, "c", "mock_test.go")
+
+	content, err := os.ReadFile(srcFile)
+	require.NoError(t, err)
+
+	err = os.WriteFile(srcFile, bytes.ReplaceAll(content, []byte("newPineappleMock"), []byte("NewPineappleMock")), 0o600)
+	require.NoError(t, err)
+
+	err = os.Remove(filepath.Join(root, "c", outputMockFile))
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-export=Pineapple").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	exported, err := os.ReadFile(filepath.Join(root, "c", outputExportedMockFile))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(exported), "func NewPineappleMock(")
+	assert.NotContains(t, string(exported), "coconutMock")
+
+	testOnly, err := os.ReadFile(filepath.Join(root, "c", outputMockFile))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(testOnly), "func newCoconutMock(")
+	assert.NotContains(t, string(testOnly), "pineappleMock")
+
+	cmd := exec.CommandContext(t.Context(), "go", "test", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+}
+
+func TestMocktail_cleanup(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	root := t.TempDir()
+
+	err := os.CopyFS(root, os.DirFS("./testdata/src/b"))
+	require.NoError(t, err)
+
+	// World must be called by the tests.
+	srcFile := filepath.Join(root, "c", "c.go")
+
+	content, err := os.ReadFile(srcFile)
+	require.NoError(t, err)
+
+	content = bytes.Replace(content, []byte("\tWorld() string\n"), []byte("\tWorld() string // mocktail:must\n"), 1)
+
+	err = os.WriteFile(srcFile, content, 0o600)
+	require.NoError(t, err)
+
+	mustTest := `package c
+
+import "testing"
+
+func TestMustCall(t *testing.T) {
+	if testing.Short() {
+		t.Skip("the test fails on purpose")
+	}
+
+	newPineappleMock(t)
+}
+`
+
+	err = os.WriteFile(filepath.Join(root, "c", "must_test.go"), []byte(mustTest), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("MOCKTAIL_TEST_PATH", root)
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".", "-with-cleanup").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	// The existing tests call World.
+	cmd := exec.CommandContext(t.Context(), "go", "test", "-short", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	cmd = exec.CommandContext(t.Context(), "go", "test", "-run", "TestMustCall", "./...")
+	cmd.Dir = root
+
+	output, err = cmd.CombinedOutput()
+	t.Log(string(output))
+
+	require.Error(t, err)
+
+	assert.Contains(t, string(output), "pineappleMock: the method World must be called")
+}
+
+func Test_findUnexportedType(t *testing.T) {
+	pkg := types.NewPackage("example.com/a", "a")
+
+	water := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Water", nil), types.NewStruct(nil, nil), nil)
+	juice := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "juice", nil), types.NewStruct(nil, nil), nil)
+	other := types.NewNamed(types.NewTypeName(token.NoPos, types.NewPackage("example.com/b", "b"), "pulp", nil), types.NewStruct(nil, nil), nil)
+
+	newFunc := func(result types.Type) *types.Signature {
+		return types.NewSignatureType(nil, nil, nil, nil, types.NewTuple(types.NewVar(token.NoPos, pkg, "", result)), false)
+	}
+
+	testCases := []struct {
+		desc     string
+		typ      types.Type
+		expected string
+	}{
+		{desc: "exported", typ: types.NewPointer(water), expected: ""},
+		{desc: "unexported", typ: juice, expected: "juice"},
+		{desc: "unexported of another package", typ: other, expected: ""},
+		{desc: "map", typ: types.NewMap(types.Typ[types.String], types.NewSlice(juice)), expected: "juice"},
+		{desc: "function", typ: newFunc(types.NewChan(types.RecvOnly, juice)), expected: "juice"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, findUnexportedType(test.typ, pkg))
+		})
+	}
+}
+
+func Test_findCgoType(t *testing.T) {
+	pkg := types.NewPackage("example.com/a", "a")
+
+	water := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Water", nil), types.NewStruct(nil, nil), nil)
+	cgoInt := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "_Ctype_int", nil), types.Typ[types.Int32], nil)
+	fakeInt := types.NewNamed(types.NewTypeName(token.NoPos, types.NewPackage("C", "C"), "int", nil), types.Typ[types.Invalid], nil)
+
+	testCases := []struct {
+		desc     string
+		typ      types.Type
+		expected string
+	}{
+		{desc: "not cgo", typ: types.NewPointer(water), expected: ""},
+		{desc: "unsafe pointer", typ: types.Typ[types.UnsafePointer], expected: ""},
+		{desc: "cgo", typ: cgoInt, expected: "C.int"},
+		{desc: "fake C package", typ: types.NewSlice(fakeInt), expected: "C.int"},
+		{desc: "map", typ: types.NewMap(types.Typ[types.String], types.NewPointer(cgoInt)), expected: "C.int"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, findCgoType(test.typ))
+		})
+	}
+}
+
+func TestOptions_validate_testPackage(t *testing.T) {
+	for _, testPackage := range []string{"", testPackageSame, testPackageExternal} {
+		require.NoError(t, Options{TestPackage: testPackage}.validate())
+	}
+
+	require.Error(t, Options{TestPackage: "internal"}.validate())
+	require.Error(t, Options{TestPackage: testPackageExternal, Exported: true}.validate())
+	require.Error(t, Options{TestPackage: testPackageExternal, Inline: true}.validate())
+}
+
+func TestOptions_outputDir(t *testing.T) {
+	dir, err := Options{OutDir: "internal/mocks"}.outputDir("/root", "/root/a/b")
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.FromSlash("/root/internal/mocks/a/b"), dir)
+}
+
+func TestOptions_validate_outDir(t *testing.T) {
+	require.NoError(t, Options{OutDir: "internal/mocks", Exported: true}.validate())
+
+	require.Error(t, Options{OutDir: "internal/mocks"}.validate())
+	require.Error(t, Options{OutDir: "internal/mocks", Exported: true, Inline: true}.validate())
+	require.Error(t, Options{OutDir: "../mocks", Exported: true}.validate())
+	require.Error(t, Options{OutDir: "/tmp/mocks", Exported: true}.validate())
+}
+
+func TestOptions_validate_pattern(t *testing.T) {
+	require.NoError(t, Options{Pattern: "./..."}.validate())
+
+	require.Error(t, Options{Pattern: "./...", Since: "main"}.validate())
+}
+
+func TestOptions_validate_prune(t *testing.T) {
+	require.NoError(t, Options{Prune: true}.validate())
+
+	require.Error(t, Options{Prune: true, Since: "main"}.validate())
+	require.Error(t, Options{Prune: true, Pattern: "./..."}.validate())
+	require.Error(t, Options{Prune: true, Interfaces: []string{"Carrot"}}.validate())
+}
+
+func TestOptions_validate_testifyAlias(t *testing.T) {
+	require.NoError(t, Options{TestifyAlias: "tmock"}.validate())
+
+	require.Error(t, Options{TestifyAlias: "t-mock"}.validate())
+	require.Error(t, Options{TestifyAlias: "_"}.validate())
+	require.Error(t, Options{TestifyAlias: "func"}.validate())
+}
+
+func TestOptions_validate_export(t *testing.T) {
+	require.NoError(t, Options{Export: []string{"Pineapple"}}.validate())
+
+	require.Error(t, Options{Export: []string{"Pineapple"}, Exported: true}.validate())
+	require.Error(t, Options{Export: []string{"Pineapple"}, Inline: true}.validate())
+	require.Error(t, Options{Export: []string{"Pineapple"}, TestPackage: testPackageExternal}.validate())
+	require.Error(t, Options{Export: []string{"c.Pineapple"}}.validate())
+}
+
+func Test_readInterfaceNames_grouped(t *testing.T) {
+	names, err := readInterfaceNames(filepath.Join("testdata", "src", "a", "k", srcMockFile), Options{})
+	require.NoError(t, err)
+
+	// The must annotations of the methods declared in the file are not interfaces.
+	assert.Equal(t, []string{"Reader", "Writer", "Closer"}, names)
+}
+
+func TestOptions_validate_diff(t *testing.T) {
+	require.NoError(t, Options{Diff: true}.validate())
+
+	require.Error(t, Options{Diff: true, Inline: true}.validate())
+	require.Error(t, Options{Diff: true, Prune: true}.validate())
+}
+
+func TestOptions_validate_noFormat(t *testing.T) {
+	require.NoError(t, Options{NoFormat: true}.validate())
+	require.NoError(t, Options{NoFormat: true, Format: formatGofmt}.validate())
+
+	require.Error(t, Options{NoFormat: true, Format: formatGoimports}.validate())
+}
+
+func TestOptions_formatting(t *testing.T) {
+	assert.Equal(t, formatGoimports, Options{Format: formatGoimports}.formatting())
+	assert.Equal(t, formatNone, Options{Format: formatGofmt, NoFormat: true}.formatting())
+}
+
+func TestOptions_validate_replace(t *testing.T) {
+	require.NoError(t, Options{Replace: []string{"a/b.Skin=a/c.Skin"}}.validate())
+
+	require.Error(t, Options{Replace: []string{"a/b.Skin"}}.validate())
+}
+
+func TestOptions_outputDir_mocksSubPkg(t *testing.T) {
+	dir, err := Options{MocksSubPkg: true}.outputDir("/root", "/root/a/b")
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.FromSlash("/root/a/b/mocks"), dir)
+}
+
+func TestOptions_validate_mocksSubPkg(t *testing.T) {
+	require.NoError(t, Options{MocksSubPkg: true, Exported: true}.validate())
+
+	require.Error(t, Options{MocksSubPkg: true}.validate())
+	require.Error(t, Options{MocksSubPkg: true, Exported: true, Inline: true}.validate())
+	require.Error(t, Options{MocksSubPkg: true, Exported: true, OutDir: "internal/mocks"}.validate())
+}
+
+func TestOptions_outputFileName(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		opts     Options
+		expected string
+	}{
+		{
+			desc:     "default",
+			expected: outputMockFile,
+		},
+		{
+			desc:     "exported",
+			opts:     Options{Exported: true},
+			expected: outputExportedMockFile,
+		},
+		{
+			desc:     "no test tag",
+			opts:     Options{NoTestTag: true},
+			expected: outputExportedMockFile,
+		},
+		{
+			desc:     "exported without test tag",
+			opts:     Options{Exported: true, NoTestTag: true},
+			expected: outputExportedMockFile,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.opts.outputFileName())
+		})
+	}
+}
+
+func TestOptions_mockBase(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		mockBase     string
+		expectedPath string
+		expectedName string
+	}{
+		{
+			desc:         "default",
+			expectedPath: testifyMockPkg,
+			expectedName: "Mock",
+		},
+		{
+			desc:         "custom",
+			mockBase:     "github.com/foo/ourmock.Mock",
+			expectedPath: "github.com/foo/ourmock",
+			expectedName: "Mock",
+		},
+		{
+			desc:         "custom without domain",
+			mockBase:     "a/ourmock.LogMock",
+			expectedPath: "a/ourmock",
+			expectedName: "LogMock",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			opts := Options{MockBase: test.mockBase}
+			require.NoError(t, opts.validate())
+
+			importPath, name := opts.mockBase()
+			assert.Equal(t, test.expectedPath, importPath)
+			assert.Equal(t, test.expectedName, name)
+		})
+	}
+}
+
+func TestOptions_validate_invalidReceiver(t *testing.T) {
+	testCases := []string{"1m", "m-m", "func"}
+
+	for _, receiver := range testCases {
+		t.Run(receiver, func(t *testing.T) {
+			t.Parallel()
+
+			err := Options{Receiver: receiver}.validate()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestOptions_validate_invalidMockBase(t *testing.T) {
+	testCases := []string{
+		"github.com/foo/ourmock",
+		"github.com/foo/ourmock.",
+		"github.com/foo/.Mock",
+		".Mock",
+	}
+
+	for _, mockBase := range testCases {
+		t.Run(mockBase, func(t *testing.T) {
+			t.Parallel()
+
+			err := Options{MockBase: mockBase}.validate()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestOptions_validate_funcArgs(t *testing.T) {
+	for _, funcArgs := range []string{"", funcArgsAnything, funcArgsIgnore, funcArgsName} {
+		require.NoError(t, Options{FuncArgs: funcArgs}.validate())
+	}
+
+	require.Error(t, Options{FuncArgs: "identity"}.validate())
+}
+
+func TestOptions_validate_methods(t *testing.T) {
+	require.NoError(t, Options{Methods: []string{"Pineapple.Hello"}}.validate())
+
+	for _, method := range []string{"Pineapple", "Pineapple.", "b.Carrot.Bar", ".Hello"} {
+		require.Error(t, Options{Methods: []string{method}}.validate())
+	}
+}
+
+func TestOptions_validate_invalidCallSuffix(t *testing.T) {
+	testCases := []string{"1", "Call-Type", "type"}
+
+	for _, suffix := range testCases {
+		t.Run(suffix, func(t *testing.T) {
+			t.Parallel()
+
+			err := Options{CallSuffix: suffix}.validate()
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_checkGeneratedTypeNames(t *testing.T) {
+	pkg := types.NewPackage("a", "a")
+	pkg.Scope().Insert(types.NewTypeName(token.NoPos, pkg, "coconutOpenCall", types.Typ[types.Int]))
+
+	newInterface := func(name string, methods ...string) InterfaceDesc {
+		desc := InterfaceDesc{Name: name}
+		for _, method := range methods {
+			desc.Methods = append(desc.Methods, types.NewFunc(token.NoPos, pkg, method, types.NewSignatureType(nil, nil, nil, nil, nil, false)))
+		}
+
+		return desc
+	}
+
+	testCases := []struct {
+		desc       string
+		interfaces []InterfaceDesc
+		opts       Options
+		assert     require.ErrorAssertionFunc
+	}{
+		{
+			desc:       "no clash",
+			interfaces: []InterfaceDesc{newInterface("Pineapple", "Juice")},
+			assert:     require.NoError,
+		},
+		{
+			desc:       "generated types",
+			interfaces: []InterfaceDesc{newInterface("Foo", "BarBaz"), newInterface("FooBar", "Baz")},
+			assert:     require.Error,
+		},
+		{
+			desc:       "package identifier",
+			interfaces: []InterfaceDesc{newInterface("Coconut", "Open")},
+			assert:     require.Error,
+		},
+		{
+			desc:       "package identifier with suffix",
+			interfaces: []InterfaceDesc{newInterface("Coconut", "Open")},
+			opts:       Options{CallSuffix: "Expectation"},
+			assert:     require.NoError,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkGeneratedTypeNames(PackageDesc{Pkg: pkg, Interfaces: test.interfaces}, test.opts)
+			test.assert(t, err)
+		})
+	}
+}
+
+func Test_walk_canceled(t *testing.T) {
+	root, err := filepath.Abs("./testdata/src/a")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancelCause(t.Context())
+	cancel(errors.New("timeout of 1s exceeded"))
+
+	_, err = walk(ctx, root, "a", Options{})
+	require.Error(t, err)
+
+	require.ErrorContains(t, withCause(ctx, err), "timeout of 1s exceeded")
+}
+
+// writeTree writes the files (slash-separated paths relative to the root) under the root.
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		fp := filepath.Join(root, filepath.FromSlash(name))
+
+		err := os.MkdirAll(filepath.Dir(fp), 0o750)
+		require.NoError(t, err)
+
+		err = os.WriteFile(fp, []byte(content), 0o600)
+		require.NoError(t, err)
+	}
+}
+
+func Test_keepGoing(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":         "module example.com/root\n\ngo 1.24\n",
+		"a/a.go":         "package a\n\ntype Water struct{}\n",
+		"a/mock_test.go": "package a\n\n// mocktail:Water\n",
+		"b/b.go":         "package b\n\ntype Carrot interface {\n\tPeel() error\n}\n",
+		"b/mock_test.go": "package b\n\n// mocktail:Carrot\n",
+		"c/c.go":         "package c\n\ntype Cherry interface {\n\tEat() error\n}\n",
+		"c/mock_test.go": "package c\n\n// mocktail:Cherry\n",
+	}
+
+	writeTree(t, root, files)
+
+	_, err := walk(t.Context(), root, "example.com/root", Options{})
+	require.Error(t, err)
+
+	opts := Options{KeepGoing: true, Methods: []string{"Carrot.Nope"}}
+
+	model, err := walk(t.Context(), root, "example.com/root", opts)
+	require.ErrorContains(t, err, "is not an interface")
+
+	assert.Len(t, model, 2)
+
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	err = generate(model, root, "example.com/root", opts, tmpl, &Summary{})
+	require.ErrorContains(t, err, "the interface Carrot has no method Nope")
+
+	assert.NoFileExists(t, filepath.Join(root, "b", outputMockFile))
+	assert.FileExists(t, filepath.Join(root, "c", outputMockFile))
+}
+
+func Test_mainPackage(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":           "module example.com/root\n\ngo 1.24\n",
+		"cmd/main.go":      "package main\n\ntype Greeter interface {\n\tGreet(name string) string\n}\n\nfunc main() {}\n",
+		"cmd/mock_test.go": "package main\n\n// mocktail:Greeter\n",
+		"a/a.go":           "package a\n",
+		"a/mock_test.go":   "package a\n\n// mocktail:cmd.Greeter\n",
+	}
+
+	writeTree(t, root, files)
+
+	model, err := walk(t.Context(), root, "example.com/root", Options{KeepGoing: true})
+	require.ErrorContains(t, err, `the interface Greeter belongs to the main package "example.com/root/cmd", which cannot be imported`)
+
+	require.Len(t, model, 1)
+
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	err = generate(model, root, "example.com/root", Options{Exported: true, OutDir: "mocks"}, tmpl, &Summary{})
+	require.ErrorContains(t, err, `the mocks of the main package "example.com/root/cmd" cannot be generated in another package`)
+
+	err = generate(model, root, "example.com/root", Options{}, tmpl, &Summary{})
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "cmd", outputMockFile))
+}
+
+func Test_includeTests(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":         "module example.com/root\n\ngo 1.24\n",
+		"b/b.go":         "package b\n",
+		"b/b_test.go":    "package b\n\ntype Carrot interface {\n\tPeel() error\n}\n",
+		"b/mock_test.go": "package b\n\n// mocktail:Carrot\n",
+		"a/a.go":         "package a\n",
+		"a/mock_test.go": "package a\n\n// mocktail:b.Carrot\n",
+	}
+
+	writeTree(t, root, files)
+
+	model, err := walk(t.Context(), root, "example.com/root", Options{})
+	require.NoError(t, err)
+
+	assert.Empty(t, model[filepath.Join(root, "b", srcMockFile)].Interfaces)
+
+	model, err = walk(t.Context(), root, "example.com/root", Options{IncludeTests: true, KeepGoing: true})
+	require.ErrorContains(t, err, "the interface Carrot is declared in the test file b_test.go, its mocks can only be generated in a test file of its package")
+
+	pkgDesc := model[filepath.Join(root, "b", srcMockFile)]
+	require.Len(t, pkgDesc.Interfaces, 1)
+
+	assert.Equal(t, "Carrot", pkgDesc.Interfaces[0].Name)
+}
+
+func Test_walk_methodOrder(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":         "module example.com/root\n\ngo 1.24\n",
+		"b/b.go":         "package b\n\nimport \"io\"\n\ntype Carrot interface {\n\tZest() error\n\tio.Closer\n\tPeel() error\n\tBoil() error\n}\n",
+		"b/mock_test.go": "package b\n\n// mocktail:Carrot\n",
+	}
+
+	writeTree(t, root, files)
+
+	model, err := walk(t.Context(), root, "example.com/root", Options{})
+	require.NoError(t, err)
+
+	pkgDesc := model[filepath.Join(root, "b", srcMockFile)]
+	require.Len(t, pkgDesc.Interfaces, 1)
+
+	var names []string
+	for _, method := range pkgDesc.Interfaces[0].Methods {
+		names = append(names, method.Name())
+	}
+
+	assert.Equal(t, []string{"Boil", "Close", "Peel", "Zest"}, names)
+}
+
+func Test_isImportAllowed(t *testing.T) {
+	testCases := []struct {
+		importer string
+		imported string
+		expected bool
+	}{
+		{importer: "example.com/a", imported: "example.com/b", expected: true},
+		{importer: "example.com/a", imported: "example.com/a/internal/b", expected: true},
+		{importer: "example.com/a/c/d", imported: "example.com/a/internal/b", expected: true},
+		{importer: "example.com/a/internal/b/c", imported: "example.com/a/internal/b", expected: true},
+		{importer: "example.com/c", imported: "example.com/a/internal/b", expected: false},
+		{importer: "example.com/ab", imported: "example.com/a/internal/b", expected: false},
+		{importer: "example.com/a", imported: "example.com/a/internal", expected: true},
+		{importer: "example.com/b", imported: "example.com/a/internal", expected: false},
+		{importer: "example.com/a", imported: "example.com/a/internal/b/internal/c", expected: false},
+		{importer: "example.com/a/internal/b", imported: "example.com/a/internal/b/internal/c", expected: true},
+		{importer: "example.com/a", imported: "internal/cpu", expected: true},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.importer+" "+test.imported, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, isImportAllowed(test.importer, test.imported))
+		})
+	}
+}
+
+func Test_internalPackage(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":              "module example.com/root\n\ngo 1.24\n",
+		"lib/internal/b/b.go": "package b\n\ntype Skin struct{}\n\ntype Carrot interface {\n\tPeel() *Skin\n}\n",
+		"lib/c/c.go":          "package c\n",
+		"lib/c/mock_test.go":  "package c\n\n// mocktail:lib/internal/b.Carrot\n",
+		"a/a.go":              "package a\n",
+		"a/mock_test.go":      "package a\n\n// mocktail:lib/internal/b.Carrot\n",
+	}
+
+	writeTree(t, root, files)
+
+	model, err := walk(t.Context(), root, "example.com/root", Options{KeepGoing: true})
+	require.ErrorContains(t, err, `the interface Carrot belongs to the internal package "example.com/root/lib/internal/b", which cannot be imported from "example.com/root/a"`)
+
+	require.Len(t, model, 1)
+
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	err = generate(model, root, "example.com/root", Options{}, tmpl, &Summary{})
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "lib", "c", outputMockFile))
+
+	// The output directory is outside of the tree of the internal package.
+	err = generate(model, root, "example.com/root", Options{Exported: true, OutDir: "mocks"}, tmpl, &Summary{})
+	require.ErrorContains(t, err, `the mocks of the package "example.com/root/mocks/lib/c" cannot import the internal package "example.com/root/lib/internal/b"`)
+}
+
+func Test_unexportedMethod(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":         "module example.com/root\n\ngo 1.24\n",
+		"b/b.go":         "package b\n\ntype ShirleyTemple interface {\n\tale() string\n\tPour() error\n}\n",
+		"b/mock_test.go": "package b\n\n// mocktail:ShirleyTemple\n",
+	}
+
+	writeTree(t, root, files)
+
+	model, err := walk(t.Context(), root, "example.com/root", Options{})
+	require.NoError(t, err)
+
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	// Only a warning: the exported mock substitutes the interface inside its package.
+	err = generate(model, root, "example.com/root", Options{Exported: true}, tmpl, &Summary{})
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "b", outputExportedMockFile))
+
+	err = generate(model, root, "example.com/root", Options{Exported: true, MocksSubPkg: true}, tmpl, &Summary{})
+	require.ErrorContains(t, err, "the method ShirleyTemple.ale is unexported: the mock cannot implement the interface outside of its package")
+}
+
+func Test_findUnexportedMethod(t *testing.T) {
+	pkg := types.NewPackage("example.com/root/b", "b")
+	sig := types.NewSignatureType(nil, nil, nil, nil, nil, false)
+
+	methods := []*types.Func{
+		types.NewFunc(token.NoPos, pkg, "Pour", sig),
+		types.NewFunc(token.NoPos, pkg, "ale", sig),
+	}
+
+	assert.Equal(t, "ale", findUnexportedMethod(InterfaceDesc{Name: "ShirleyTemple", Methods: methods}))
+	assert.Empty(t, findUnexportedMethod(InterfaceDesc{Name: "ShirleyTemple", Methods: methods[:1]}))
+}
+
+func Test_replace(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":              "module example.com/root\n\ngo 1.24\n",
+		"lib/internal/b/b.go": "package b\n\ntype Skin struct{}\n",
+		"lib/skin/skin.go":    "package skin\n\nimport \"example.com/root/lib/internal/b\"\n\ntype Skin = b.Skin\n",
+		"lib/c/c.go":          "package c\n\nimport \"example.com/root/lib/internal/b\"\n\ntype Carrot interface {\n\tPeel() *b.Skin\n}\n",
+		"lib/c/mock_test.go":  "package c\n\n// mocktail:Carrot\n",
+	}
+
+	writeTree(t, root, files)
+
+	opts := Options{Exported: true, OutDir: "mocks", Replace: []string{"example.com/root/lib/internal/b.Skin=example.com/root/lib/skin.Skin"}}
+
+	model, err := walk(t.Context(), root, "example.com/root", opts)
+	require.NoError(t, err)
+
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	// The output directory is outside of the tree of the internal package: the public alias is used instead.
+	err = generate(model, root, "example.com/root", opts, tmpl, &Summary{})
+	require.NoError(t, err)
+
+	genBytes, err := os.ReadFile(filepath.Join(root, "mocks", "lib", "c", outputExportedMockFile))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(genBytes), `"example.com/root/lib/skin"`)
+	assert.NotContains(t, string(genBytes), `"example.com/root/lib/internal/b"`)
+	assert.Contains(t, string(genBytes), "func (_m *carrotMock) Peel() *skin.Skin {")
+}
+
+func Test_loadMode(t *testing.T) {
+	mode := packages.NeedTypes | packages.NeedSyntax
+
+	assert.Equal(t, mode|packages.NeedDeps|packages.NeedImports, loadMode(mode))
+}
+
+func Test_walk_deps(t *testing.T) {
+	root, err := filepath.Abs("./testdata/src/b")
+	require.NoError(t, err)
+
+	model, err := walk(t.Context(), root, "b", Options{})
+	require.NoError(t, err)
+
+	pkgDesc := model[filepath.Join(root, "c", srcMockFile)]
+	require.Len(t, pkgDesc.Interfaces, 2)
+
+	assert.Contains(t, pkgDesc.Imports, "golang.org/x/mod/module")
+}
+
+func TestOptions_isIgnored(t *testing.T) {
+	opts := Options{Ignore: []string{"third_party", "internal/gen*", "*_old"}}
+
+	testCases := []struct {
+		fp       string
+		expected bool
+	}{
+		{fp: "/root", expected: false},
+		{fp: "/root/third_party", expected: true},
+		{fp: "/root/a/third_party", expected: true},
+		{fp: "/root/internal/generated", expected: true},
+		{fp: "/root/a/internal/generated", expected: false},
+		{fp: "/root/a/b_old", expected: true},
+		{fp: "/root/a/b", expected: false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.fp, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, opts.isIgnored("/root", test.fp))
+		})
+	}
+}
+
+func Test_getTypeImports_error(t *testing.T) {
+	errorType := types.Universe.Lookup("error").Type()
+
+	testCases := []struct {
+		desc string
+		typ  types.Type
+	}{
+		{desc: "error", typ: errorType},
+		{desc: "slice of errors", typ: types.NewSlice(errorType)},
+		{desc: "map of errors", typ: types.NewMap(types.Typ[types.String], errorType)},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			for _, imp := range getTypeImports(test.typ, nil) {
+				assert.Empty(t, imp)
+			}
+		})
+	}
+}
+
+func Test_getTypeImports_typeArgs(t *testing.T) {
+	pagePkg := types.NewPackage("example.com/page", "page")
+	userPkg := types.NewPackage("example.com/user", "user")
+
+	tparam := types.NewTypeParam(types.NewTypeName(token.NoPos, pagePkg, "T", nil), types.Universe.Lookup("any").Type())
+	page := types.NewNamed(types.NewTypeName(token.NoPos, pagePkg, "Page", nil), types.NewStruct(nil, nil), nil)
+	page.SetTypeParams([]*types.TypeParam{tparam})
+
+	user := types.NewNamed(types.NewTypeName(token.NoPos, userPkg, "User", nil), types.NewStruct(nil, nil), nil)
+
+	inst, err := types.Instantiate(nil, page, []types.Type{user}, true)
+	require.NoError(t, err)
+
+	expected := []string{"example.com/page", "example.com/user"}
+
+	assert.Equal(t, expected, getTypeImports(types.NewPointer(inst), nil))
+	assert.Equal(t, expected, getTypeImports(types.NewSlice(inst), nil))
+	assert.Equal(t, append([]string{""}, expected...), getTypeImports(types.NewMap(types.Typ[types.String], types.NewPointer(inst)), nil))
+}
+
+func Test_getTypeImports_pointerToArray(t *testing.T) {
+	recordPkg := types.NewPackage("example.com/record", "record")
+	entry := types.NewNamed(types.NewTypeName(token.NoPos, recordPkg, "Entry", nil), types.NewStruct(nil, nil), nil)
+
+	for _, imp := range getTypeImports(types.NewPointer(types.NewArray(types.Universe.Lookup("byte").Type(), 32)), nil) {
+		assert.Empty(t, imp)
+	}
+
+	assert.Equal(t, []string{"example.com/record"}, getTypeImports(types.NewPointer(types.NewArray(entry, 4)), nil))
+}
+
+func Test_getMethodImports(t *testing.T) {
+	pkg := types.NewPackage("example.com/a", "a")
+	ioPkg := types.NewPackage("io", "io")
+	modulePkg := types.NewPackage("golang.org/x/mod/module", "module")
+
+	reader := types.NewNamed(types.NewTypeName(token.NoPos, ioPkg, "Reader", nil), types.NewInterfaceType(nil, nil), nil)
+	version := types.NewNamed(types.NewTypeName(token.NoPos, modulePkg, "Version", nil), types.NewStruct(nil, nil), nil)
+	water := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Water", nil), types.NewStruct(nil, nil), nil)
+
+	newFunc := func(results ...types.Type) *types.Signature {
+		var vars []*types.Var
+		for _, result := range results {
+			vars = append(vars, types.NewVar(token.NoPos, pkg, "", result))
+		}
+
+		return types.NewSignatureType(nil, nil, nil, nil, types.NewTuple(vars...), false)
+	}
+
+	testCases := []struct {
+		desc     string
+		result   types.Type
+		expected []string
+	}{
+		{
+			desc:     "function returning a stdlib type",
+			result:   newFunc(reader),
+			expected: []string{"io"},
+		},
+		{
+			desc:     "function returning a third-party type",
+			result:   newFunc(types.NewPointer(version)),
+			expected: []string{"golang.org/x/mod/module"},
+		},
+		{
+			desc:     "function returning a function",
+			result:   newFunc(newFunc(reader, version)),
+			expected: []string{"io", "golang.org/x/mod/module"},
+		},
+		{
+			desc:     "function returning a type of the package",
+			result:   newFunc(water),
+			expected: nil,
+		},
+		{
+			desc:     "channel of functions",
+			result:   types.NewChan(types.RecvOnly, newFunc(reader)),
+			expected: []string{"io"},
+		},
+		{
+			desc: "interface returning a function",
+			result: types.NewInterfaceType([]*types.Func{
+				types.NewFunc(token.NoPos, pkg, "Open", newFunc(newFunc(version))),
+			}, nil),
+			expected: []string{"golang.org/x/mod/module"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			method := types.NewFunc(token.NoPos, pkg, "Get", newFunc(test.result))
+
+			assert.Equal(t, test.expected, getMethodImports(method, pkg.Path(), nil))
+		})
+	}
+}
+
+func Test_getConstraintImports(t *testing.T) {
+	pkg := types.NewPackage("example.com/a", "a")
+	cmpPkg := types.NewPackage("cmp", "cmp")
+
+	ordered := types.NewNamed(types.NewTypeName(token.NoPos, cmpPkg, "Ordered", nil), types.NewInterfaceType(nil, nil), nil)
+	alias := types.NewAlias(types.NewTypeName(token.NoPos, pkg, "key", nil), ordered)
+	exportedAlias := types.NewAlias(types.NewTypeName(token.NoPos, types.NewPackage("example.com/b", "b"), "Key", nil), ordered)
+
+	// type Self[T Self[T]] interface{}
+	self := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Self", nil), nil, nil)
+	selfParam := types.NewTypeParam(types.NewTypeName(token.NoPos, pkg, "T", nil), nil)
+	self.SetTypeParams([]*types.TypeParam{selfParam})
+	self.SetUnderlying(types.NewInterfaceType(nil, nil))
+
+	selfConstraint, err := types.Instantiate(nil, self, []types.Type{selfParam}, false)
+	require.NoError(t, err)
+
+	selfParam.SetConstraint(selfConstraint)
+
+	newTypeParams := func(constraint types.Type) *types.TypeParamList {
+		tp := types.NewTypeParam(types.NewTypeName(token.NoPos, pkg, "K", nil), constraint)
+
+		return types.NewSignatureType(nil, nil, []*types.TypeParam{tp}, nil, nil, false).TypeParams()
+	}
+
+	testCases := []struct {
+		desc       string
+		typeParams *types.TypeParamList
+		expected   []string
+	}{
+		{
+			desc:       "foreign constraint",
+			typeParams: newTypeParams(ordered),
+			expected:   []string{"cmp"},
+		},
+		{
+			desc:       "unexported alias of a foreign constraint",
+			typeParams: newTypeParams(alias),
+			expected:   []string{"cmp"},
+		},
+		{
+			desc:       "exported alias of a foreign constraint",
+			typeParams: newTypeParams(exportedAlias),
+			expected:   []string{"example.com/b"},
+		},
+		{
+			desc:       "self-referential constraint",
+			typeParams: self.TypeParams(),
+			expected:   nil,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, getConstraintImports(test.typeParams, pkg.Path(), nil))
+		})
+	}
+}
+
+func Test_formatSource(t *testing.T) {
+	src := []byte("package a\n\nimport (\n\t\"time\"\n\t\"fmt\"\n)\n\nfunc  a() time.Duration { return 0 }\n")
+
+	testCases := []struct {
+		formatting string
+		expected   string
+	}{
+		{
+			formatting: formatGofmt,
+			expected:   "package a\n\nimport (\n\t\"fmt\"\n\t\"time\"\n)\n\nfunc a() time.Duration { return 0 }\n",
+		},
+		{
+			formatting: formatGoimports,
+			expected:   "package a\n\nimport (\n\t\"time\"\n)\n\nfunc a() time.Duration { return 0 }\n",
+		},
+		{
+			formatting: formatNone,
+			expected:   string(src),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.formatting, func(t *testing.T) {
+			t.Parallel()
+
+			source, err := formatSource("a.go", src, test.formatting)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, string(source))
+		})
+	}
+}
+
+func TestOptions_validate_format(t *testing.T) {
+	for _, formatting := range []string{"", formatGofmt, formatGoimports, formatNone} {
+		require.NoError(t, Options{Format: formatting}.validate())
+	}
+
+	require.Error(t, Options{Format: "gofumpt"}.validate())
+}
+
+func Test_withSourceContext(t *testing.T) {
+	src := []byte("package a\n\nfunc a() {\n\tfoo(\n}\n\nfunc b() {}\n")
+
+	_, err := format.Source(src)
+	require.Error(t, err)
+
+	err = withSourceContext(src, err)
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), ">     5 | }")
+	assert.Contains(t, err.Error(), "      4 | \tfoo(")
+	assert.Contains(t, err.Error(), "      7 | func b() {}")
+	assert.Contains(t, err.Error(), "      2 | \n")
+	assert.NotContains(t, err.Error(), "package a")
+}
+
+func TestOptions_commentTag(t *testing.T) {
+	assert.Equal(t, commentTagPattern, Options{}.commentTag())
+	assert.Equal(t, commentTagPattern, Options{CommentTag: " "}.commentTag())
+	assert.Equal(t, "// mockgen:", Options{CommentTag: "// mockgen:"}.commentTag())
+}
+
+func Test_relativePath(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join("a", "mock_gen_test.go"), relativePath(filepath.Join(wd, "a", "mock_gen_test.go")))
+	assert.Equal(t, "mock_gen_test.go", relativePath("mock_gen_test.go"))
+}
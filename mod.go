@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -19,10 +20,13 @@ type modInfo struct {
 	GoMod     string `json:"GoMod"` // absolute path to the go.mod
 	GoVersion string `json:"GoVersion"`
 	Main      bool   `json:"Main"`
+	GoWork    string `json:"-"` // absolute path to the go.work, at the root of a workspace (without module)
 }
 
+// getModuleInfo returns the module of the directory.
+// Outside of the modules of a go.work workspace, the module is the root of the workspace: without path, with the go.work.
 func getModuleInfo(ctx context.Context, dir string) (modInfo, error) {
-	cmd := exec.CommandContext(ctx, "go", "env", "-json", "GOMOD")
+	cmd := exec.CommandContext(ctx, "go", "env", "-json", "GOMOD", "GOWORK")
 	if dir != "" {
 		cmd.Dir = dir
 	}
@@ -39,8 +43,19 @@ func getModuleInfo(ctx context.Context, dir string) (modInfo, error) {
 		return modInfo{}, err
 	}
 
-	goModPath := v["GOMOD"]
+	if v["GOMOD"] != "" && v["GOMOD"] != os.DevNull {
+		return readModuleInfo(v["GOMOD"])
+	}
+
+	if v["GOWORK"] != "" && v["GOWORK"] != "off" {
+		return modInfo{Dir: filepath.Dir(v["GOWORK"]), GoWork: v["GOWORK"]}, nil
+	}
+
+	return modInfo{}, errors.New("go.mod file not found in the directory or any parent directory")
+}
 
+// readModuleInfo reads the module information from a go.mod file.
+func readModuleInfo(goModPath string) (modInfo, error) {
 	data, err := os.ReadFile(goModPath)
 	if err != nil {
 		return modInfo{}, err
@@ -59,3 +74,19 @@ func getModuleInfo(ctx context.Context, dir string) (modInfo, error) {
 		Main:      true,
 	}, nil
 }
+
+// findModule returns the nearest module containing the directory.
+func findModule(modules map[string]modInfo, dir string) (modInfo, bool) {
+	for {
+		if info, ok := modules[dir]; ok {
+			return info, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return modInfo{}, false
+		}
+
+		dir = parent
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findModule(t *testing.T) {
+	modules := map[string]modInfo{
+		"/root":         {Path: "example.com/root"},
+		"/root/a/inner": {Path: "example.com/inner"},
+	}
+
+	testCases := []struct {
+		dir      string
+		expected string
+	}{
+		{dir: "/root", expected: "example.com/root"},
+		{dir: "/root/a", expected: "example.com/root"},
+		{dir: "/root/a/inner", expected: "example.com/inner"},
+		{dir: "/root/a/inner/b", expected: "example.com/inner"},
+		{dir: "/root/a/innerb", expected: "example.com/root"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.dir, func(t *testing.T) {
+			t.Parallel()
+
+			info, ok := findModule(modules, test.dir)
+			require.True(t, ok)
+
+			assert.Equal(t, test.expected, info.Path)
+		})
+	}
+
+	_, ok := findModule(modules, "/other")
+	assert.False(t, ok)
+}
+
+func Test_walk_nestedModule(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":               "module example.com/root\n\ngo 1.24\n",
+		"inner/go.mod":         "module example.com/inner\n\ngo 1.24\n",
+		"inner/b/b.go":         "package b\n\ntype Carrot interface {\n\tPeel() error\n}\n",
+		"inner/b/mock_test.go": "package b\n\n// mocktail:Carrot\n",
+	}
+
+	writeTree(t, root, files)
+
+	model, err := walk(t.Context(), root, "example.com/root", Options{})
+	require.NoError(t, err)
+
+	pkgDesc, ok := model[filepath.Join(root, "inner", "b", srcMockFile)]
+	require.True(t, ok)
+
+	assert.Equal(t, "example.com/inner/b", pkgDesc.Pkg.Path())
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// mustCallAnnotation is the suffix of the comment tag marking the methods which must be called by the tests.
+const mustCallAnnotation = "must"
+
+// getMustCallMethods returns the names of the methods annotated with the must comment (e.g. `// mocktail:must`),
+// in the doc or in the line comment of the method in the declaration of the interface.
+// Only the methods declared in the loaded files can be annotated.
+func getMustCallMethods(files []*ast.File, methods []*types.Func, commentTag string) []string {
+	fields := map[token.Pos]*ast.Field{}
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			iface, ok := node.(*ast.InterfaceType)
+			if !ok {
+				return true
+			}
+
+			for _, field := range iface.Methods.List {
+				for _, name := range field.Names {
+					fields[name.Pos()] = field
+				}
+			}
+
+			return true
+		})
+	}
+
+	annotation := strings.TrimSpace(commentTag + mustCallAnnotation)
+
+	var names []string
+
+	for _, method := range methods {
+		field, ok := fields[method.Pos()]
+		if !ok {
+			continue
+		}
+
+		if hasAnnotation(field.Doc, annotation) || hasAnnotation(field.Comment, annotation) {
+			names = append(names, method.Name())
+		}
+	}
+
+	return names
+}
+
+// hasAnnotation reports whether a comment of the group is the annotation.
+func hasAnnotation(group *ast.CommentGroup, annotation string) bool {
+	if group == nil {
+		return false
+	}
+
+	for _, comment := range group.List {
+		if strings.TrimSpace(comment.Text) == annotation {
+			return true
+		}
+	}
+
+	return false
+}
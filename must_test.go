@@ -0,0 +1,53 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getMustCallMethods(t *testing.T) {
+	src := `package a
+
+import "io"
+
+type Writer interface {
+	io.Closer
+
+	// mocktail:must
+	Flush() error
+
+	// Write writes the data.
+	Write(p []byte) (int, error) // mocktail:must
+
+	// mocktail:must is not an annotation when followed by other words.
+	Reset()
+}
+`
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "a.go", src, parser.ParseComments)
+	require.NoError(t, err)
+
+	conf := types.Config{Importer: importer.Default()}
+
+	pkg, err := conf.Check("a", fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+
+	iface := pkg.Scope().Lookup("Writer").Type().Underlying().(*types.Interface)
+
+	methods := make([]*types.Func, 0, iface.NumMethods())
+	for method := range iface.Methods() {
+		methods = append(methods, method)
+	}
+
+	assert.Equal(t, []string{"Flush", "Write"}, getMustCallMethods([]*ast.File{file}, methods, commentTagPattern))
+	assert.Empty(t, getMustCallMethods([]*ast.File{file}, methods, "// mock:"))
+}
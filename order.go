@@ -0,0 +1,19 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+)
+
+// sortByDeclaration sorts the interfaces by the position of their declaration:
+// by import path of their package, then by file and by position in the file.
+func sortByDeclaration(interfaces []InterfaceDesc) {
+	slices.SortStableFunc(interfaces, func(a, b InterfaceDesc) int {
+		return cmp.Or(
+			cmp.Compare(a.PkgPath, b.PkgPath),
+			cmp.Compare(a.Position.Filename, b.Position.Filename),
+			cmp.Compare(a.Position.Line, b.Position.Line),
+			cmp.Compare(a.Position.Column, b.Position.Column),
+		)
+	})
+}
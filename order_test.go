@@ -0,0 +1,27 @@
+package main
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sortByDeclaration(t *testing.T) {
+	interfaces := []InterfaceDesc{
+		{Name: "Pineapple", PkgPath: "a", Position: token.Position{Filename: "/a/a.go", Line: 20, Column: 6}},
+		{Name: "Carrot", PkgPath: "a/b", Position: token.Position{Filename: "/a/b/b.go", Line: 3, Column: 6}},
+		{Name: "Coconut", PkgPath: "a", Position: token.Position{Filename: "/a/a.go", Line: 8, Column: 6}},
+		{Name: "Banana", PkgPath: "a", Position: token.Position{Filename: "/a/a.go", Line: 8, Column: 2}},
+		{Name: "Lemon", PkgPath: "a", Position: token.Position{Filename: "/a/a_other.go", Line: 1, Column: 6}},
+	}
+
+	sortByDeclaration(interfaces)
+
+	var names []string
+	for _, interfaceDesc := range interfaces {
+		names = append(names, interfaceDesc.Name)
+	}
+
+	assert.Equal(t, []string{"Banana", "Coconut", "Pineapple", "Lemon", "Carrot"}, names)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// parseOutputMapping returns the source file and the destination file of an output mapping entry (src=dst).
+// Both paths are relative to the module root.
+func parseOutputMapping(entry string) (string, string, error) {
+	src, dst, ok := strings.Cut(entry, "=")
+	if !ok || !filepath.IsLocal(src) || !filepath.IsLocal(dst) || filepath.Ext(dst) != ".go" {
+		return "", "", fmt.Errorf("invalid output mapping %q: the expected format is path/to/mock_test.go=path/to/mocks.go, relative to the module root", entry)
+	}
+
+	return filepath.Clean(src), filepath.Clean(dst), nil
+}
+
+// mappedOutputFile returns the generated file of the mocks referenced by the file fp, from the output mapping.
+// ok is false when the file is not mapped. The entries are checked by the validation of the options.
+func (o Options) mappedOutputFile(root, fp string) (string, bool) {
+	for _, entry := range o.OutputMap {
+		src, dst, err := parseOutputMapping(entry)
+		if err != nil {
+			continue
+		}
+
+		if filepath.Join(root, src) == fp {
+			return filepath.Join(root, dst), true
+		}
+	}
+
+	return "", false
+}
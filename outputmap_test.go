@@ -0,0 +1,47 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseOutputMapping(t *testing.T) {
+	src, dst, err := parseOutputMapping("a/b/mock_test.go=a/b/mocks/../b_mock_test.go")
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join("a", "b", "mock_test.go"), src)
+	assert.Equal(t, filepath.Join("a", "b", "b_mock_test.go"), dst)
+
+	for _, entry := range []string{"a/b/mock_test.go", "a/b/mock_test.go=", "/a/mock_test.go=a/mocks.go", "a/mock_test.go=../mocks.go", "a/mock_test.go=a/mocks"} {
+		_, _, err = parseOutputMapping(entry)
+		assert.Error(t, err, entry)
+	}
+}
+
+func TestOptions_outputFile_outputMap(t *testing.T) {
+	root := filepath.FromSlash("/root")
+
+	opts := Options{Exported: true, OutputMap: []string{"c/mock_test.go=mocks/c_mock.go"}}
+
+	out, err := opts.outputFile(root, filepath.Join(root, "c", srcMockFile))
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(root, "mocks", "c_mock.go"), out)
+
+	out, err = opts.outputFile(root, filepath.Join(root, "d", srcMockFile))
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(root, "d", outputExportedMockFile), out)
+}
+
+func TestOptions_validate_outputMap(t *testing.T) {
+	require.NoError(t, Options{OutputMap: []string{"c/mock_test.go=c/c_mock_test.go"}}.validate())
+	require.NoError(t, Options{OutputMap: []string{"c/mock_test.go=mocks/c.go"}, Exported: true}.validate())
+
+	require.Error(t, Options{OutputMap: []string{"c/mock_test.go=mocks/c.go"}}.validate())
+	require.Error(t, Options{OutputMap: []string{"c/mock_test.go"}}.validate())
+	require.Error(t, Options{OutputMap: []string{"c/mock_test.go=c/c_mock_test.go"}, Inline: true}.validate())
+}
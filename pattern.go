@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/types"
+	"path/filepath"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// walkPattern builds the model of the interfaces declared in the packages matching a go/packages pattern (e.g. `./...`).
+// All the interfaces of the packages passing the interface filter are mocked:
+// the mocks of a package are generated as if a `mock_test.go` file of the package referenced its interfaces.
+func walkPattern(ctx context.Context, root string, opts Options) (map[string]PackageDesc, error) {
+	pkgs, err := packages.Load(
+		&packages.Config{
+			Context: ctx,
+			// The packages are type-checked from the source rather than read from the export data.
+			Mode: loadMode(packages.NeedName | packages.NeedFiles | packages.NeedTypes | packages.NeedModule | packages.NeedSyntax),
+			Dir:  root,
+		},
+		opts.Pattern,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("load pattern %q: %w", opts.Pattern, err)
+	}
+
+	model := make(map[string]PackageDesc)
+
+	// The errors of the packages, with the keep-going option.
+	var errs []error
+
+	for _, pkg := range pkgs {
+		fp, packageDesc, err := readPatternPackage(ctx, root, pkg, opts)
+		if err != nil {
+			if !opts.KeepGoing {
+				return nil, err
+			}
+
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if len(packageDesc.Interfaces) > 0 {
+			model[fp] = packageDesc
+		}
+	}
+
+	return model, errors.Join(errs...)
+}
+
+// readPatternPackage returns the path of the virtual `mock_test.go` file of a package matching the pattern,
+// and the description of the interfaces of the package.
+func readPatternPackage(ctx context.Context, root string, pkg *packages.Package, opts Options) (string, PackageDesc, error) {
+	if len(pkg.Errors) > 0 {
+		return "", PackageDesc{}, fmt.Errorf("package %q: %w", pkg.PkgPath, pkg.Errors[0])
+	}
+
+	if len(pkg.GoFiles) == 0 {
+		return "", PackageDesc{}, nil
+	}
+
+	dir := filepath.Dir(pkg.GoFiles[0])
+
+	// The mocks are written into the packages: the packages of the dependencies and of the standard library are read-only.
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || !filepath.IsLocal(rel) || pkg.Module == nil {
+		return "", PackageDesc{}, fmt.Errorf("package %q: the package is outside of the module %q", pkg.PkgPath, root)
+	}
+
+	if opts.isIgnored(root, dir) {
+		return "", PackageDesc{}, nil
+	}
+
+	names := getPackageInterfaces(pkg.Types, opts)
+	if len(names) == 0 {
+		return "", PackageDesc{}, nil
+	}
+
+	if err := opts.checkInterfaceCollisions(names); err != nil {
+		return "", PackageDesc{}, fmt.Errorf("package %q: %w", pkg.PkgPath, err)
+	}
+
+	fp := filepath.Join(dir, srcMockFile)
+
+	packageDesc, err := readPackageDesc(ctx, modInfo{Path: pkg.Module.Path, Dir: pkg.Module.Dir}, fp, names, opts)
+	if err != nil {
+		return "", PackageDesc{}, fmt.Errorf("%s: %w", relativePath(fp), err)
+	}
+
+	return fp, packageDesc, nil
+}
+
+// getPackageInterfaces returns the sorted names of the interfaces declared in the package, which can be mocked
+// (with methods, without type terms) and which pass the interface filter.
+func getPackageInterfaces(pkg *types.Package, opts Options) []string {
+	var names []string
+
+	for _, name := range pkg.Scope().Names() {
+		obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+
+		iface, ok := obj.Type().Underlying().(*types.Interface)
+		if !ok || !iface.IsMethodSet() || iface.NumMethods() == 0 {
+			continue
+		}
+
+		if opts.isInterfaceSelected(name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_walkPattern(t *testing.T) {
+	root, err := filepath.Abs("./testdata/src/b")
+	require.NoError(t, err)
+
+	model, err := walkPattern(t.Context(), root, Options{Pattern: "./..."})
+	require.NoError(t, err)
+
+	require.Len(t, model, 1)
+
+	pkgDesc := model[filepath.Join(root, "c", srcMockFile)]
+	var names []string
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		names = append(names, interfaceDesc.Name)
+	}
+
+	assert.Equal(t, []string{"Coconut", "Pineapple", "Strawberry"}, names)
+
+	model, err = walkPattern(t.Context(), root, Options{Pattern: "b/c", Interfaces: []string{"Pineapple"}})
+	require.NoError(t, err)
+
+	pkgDesc = model[filepath.Join(root, "c", srcMockFile)]
+	require.Len(t, pkgDesc.Interfaces, 1)
+
+	assert.Equal(t, "Pineapple", pkgDesc.Interfaces[0].Name)
+}
+
+func Test_walkPattern_outside(t *testing.T) {
+	root, err := filepath.Abs("./testdata/src/b")
+	require.NoError(t, err)
+
+	_, err = walkPattern(t.Context(), root, Options{Pattern: "io"})
+	require.ErrorContains(t, err, `package "io": the package is outside of the module`)
+}
+
+func Test_getPackageInterfaces(t *testing.T) {
+	src := `package a
+
+import "io"
+
+type Reader = io.Reader
+
+type Number interface{ ~int | ~float64 }
+
+type Empty interface{}
+
+type Water struct{}
+
+type Closer interface{ io.Closer }
+
+type pourer interface{ Pour() error }
+
+type Cache[K comparable, V any] interface{ Get(key K) V }
+`
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "a.go", src, 0)
+	require.NoError(t, err)
+
+	conf := types.Config{Importer: importer.Default()}
+
+	pkg, err := conf.Check("a", fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Cache", "Closer", "pourer"}, getPackageInterfaces(pkg, Options{}))
+	assert.Equal(t, []string{"Closer"}, getPackageInterfaces(pkg, Options{Interfaces: []string{"Closer"}}))
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generatedMarker is the generated-code marker written at the top of the generated files.
+const generatedMarker = "// Code generated by mocktail; DO NOT EDIT."
+
+// prune removes the generated files which are not generated anymore by the model:
+// the files named like the generated files, carrying the generated-code marker of mocktail,
+// whose source doesn't reference any interface.
+// The directories of the modules are pruned, the output files of a package are relative to the directory of its module.
+// Nothing is removed when the model is empty.
+func prune(modules []modInfo, model map[string]PackageDesc, opts Options) error {
+	// An empty model is more likely a misconfiguration (e.g. a wrong -comment-tag, a too broad -ignore)
+	// than the removal of all the interfaces: pruning would remove all the mocks.
+	if len(model) == 0 {
+		log.Printf("prune: no interface found, the generated files are kept")
+		return nil
+	}
+
+	byDir := map[string]modInfo{}
+	for _, module := range modules {
+		byDir[module.Dir] = module
+	}
+
+	kept := map[string]struct{}{}
+
+	for fp, pkgDesc := range model {
+		module, _ := findModule(byDir, filepath.Dir(fp))
+
+		for _, file := range splitExported(pkgDesc, opts) {
+			out, err := file.opts.outputFile(module.Dir, fp)
+			if err != nil {
+				return err
+			}
+
+			kept[out] = struct{}{}
+		}
+	}
+
+	for _, module := range walkedModules(modules) {
+		err := pruneDir(module.Dir, kept, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneDir removes the generated files of the directory which are not kept.
+func pruneDir(root string, kept map[string]struct{}, opts Options) error {
+	return filepath.WalkDir(root, func(fp string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == "testdata" || d.Name() == "vendor" || opts.isIgnored(root, fp) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.Name() != outputMockFile && d.Name() != outputExportedMockFile {
+			return nil
+		}
+
+		if _, ok := kept[fp]; ok || !isGeneratedFile(fp) {
+			return nil
+		}
+
+		err = os.Remove(fp)
+		if err != nil {
+			return fmt.Errorf("remove file: %w", err)
+		}
+
+		opts.logEvent(eventPruned, fmt.Sprintf("%s: pruned", relativePath(fp)), "file", relativePath(fp))
+
+		return nil
+	})
+}
+
+// isGeneratedFile reports whether the file carries the generated-code marker of mocktail, before the package clause.
+func isGeneratedFile(fp string) bool {
+	file, err := os.Open(fp)
+	if err != nil {
+		return false
+	}
+
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == generatedMarker {
+			return true
+		}
+
+		if strings.HasPrefix(line, "package ") {
+			return false
+		}
+	}
+
+	return false
+}
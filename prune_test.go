@@ -0,0 +1,103 @@
+package main
+
+import (
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_prune(t *testing.T) {
+	root := t.TempDir()
+
+	generated := generatedMarker + "\n\npackage a\n"
+
+	files := map[string]string{
+		"a/mock_gen_test.go":          generated,
+		"b/mock_gen_test.go":          generated,
+		"c/mock_gen_test.go":          "// Code written by hand.\n\npackage c\n",
+		"d/mock_gen.go":               "// SPDX-License-Identifier: MIT\n" + generated,
+		"e/mocks.go":                  generated,
+		"testdata/f/mock_gen_test.go": generated,
+	}
+
+	writeTree(t, root, files)
+
+	model := map[string]PackageDesc{filepath.Join(root, "a", srcMockFile): {}}
+
+	err := prune([]modInfo{{Dir: root}}, model, Options{})
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "a", outputMockFile))
+	assert.NoFileExists(t, filepath.Join(root, "b", outputMockFile))
+	assert.FileExists(t, filepath.Join(root, "c", outputMockFile))
+	assert.NoFileExists(t, filepath.Join(root, "d", outputExportedMockFile))
+	assert.FileExists(t, filepath.Join(root, "e", "mocks.go"))
+	assert.FileExists(t, filepath.Join(root, "testdata", "f", outputMockFile))
+}
+
+func Test_prune_export(t *testing.T) {
+	root := t.TempDir()
+
+	generated := []byte(generatedMarker + "\n\npackage a\n")
+
+	err := os.MkdirAll(filepath.Join(root, "a"), 0o750)
+	require.NoError(t, err)
+
+	for _, name := range []string{outputMockFile, outputExportedMockFile} {
+		err = os.WriteFile(filepath.Join(root, "a", name), generated, 0o600)
+		require.NoError(t, err)
+	}
+
+	pkgDesc := PackageDesc{
+		Pkg:        types.NewPackage("example.com/a", "a"),
+		Interfaces: []InterfaceDesc{{Name: "Pineapple"}, {Name: "Coconut"}},
+	}
+
+	model := map[string]PackageDesc{filepath.Join(root, "a", srcMockFile): pkgDesc}
+
+	err = prune([]modInfo{{Dir: root}}, model, Options{Export: []string{"Pineapple"}})
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "a", outputMockFile))
+	assert.FileExists(t, filepath.Join(root, "a", outputExportedMockFile))
+
+	// All the mocks are exported: the test file is stale.
+	err = prune([]modInfo{{Dir: root}}, model, Options{Export: []string{"Pineapple", "Coconut"}})
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, filepath.Join(root, "a", outputMockFile))
+	assert.FileExists(t, filepath.Join(root, "a", outputExportedMockFile))
+}
+
+func Test_prune_emptyModel(t *testing.T) {
+	root := t.TempDir()
+
+	err := os.MkdirAll(filepath.Join(root, "a"), 0o750)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(root, "a", outputMockFile), []byte(generatedMarker+"\n\npackage a\n"), 0o600)
+	require.NoError(t, err)
+
+	// No interface found (e.g. a wrong comment tag): the generated files are kept.
+	err = prune([]modInfo{{Dir: root}}, map[string]PackageDesc{}, Options{CommentTag: "// mocktial:"})
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "a", outputMockFile))
+}
+
+func Test_isGeneratedFile(t *testing.T) {
+	assert.True(t, isGeneratedFile("./testdata/src/a/mock_gen_test.go"))
+	assert.False(t, isGeneratedFile("./testdata/src/a/mock_test.go"))
+	assert.False(t, isGeneratedFile("./testdata/src/a/nope.go"))
+}
+
+func Test_generatedMarker(t *testing.T) {
+	content, err := os.ReadFile("templates.go.tmpl")
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), generatedMarker+"\n")
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// parseReplacement returns the replaced type and its replacement (import/path.Type) of a replacement entry (old=new).
+func parseReplacement(entry string) (string, string, error) {
+	old, replacement, ok := strings.Cut(entry, "=")
+	if !ok || !isTypePath(old) || !isTypePath(replacement) {
+		return "", "", fmt.Errorf("invalid replacement %q: the expected format is old/path.Type=new/path.Type", entry)
+	}
+
+	return old, replacement, nil
+}
+
+// isTypePath reports whether the value is a qualified type name (import/path.Type).
+func isTypePath(value string) bool {
+	i := strings.LastIndex(value, ".")
+
+	return i > strings.LastIndex(value, "/")+1 && token.IsIdentifier(value[i+1:])
+}
+
+// replacements returns the replacements of the type references, by type (import/path.Type).
+// The entries are checked by the validation of the options.
+func (o Options) replacements() map[string]string {
+	if len(o.Replace) == 0 {
+		return nil
+	}
+
+	replace := map[string]string{}
+
+	for _, entry := range o.Replace {
+		old, replacement, err := parseReplacement(entry)
+		if err != nil {
+			continue
+		}
+
+		replace[old] = replacement
+	}
+
+	return replace
+}
+
+// getReplacement returns the import path and the name of the replacement of a type, ok is false without replacement.
+func getReplacement(obj *types.TypeName, replace map[string]string) (string, string, bool) {
+	if obj.Pkg() == nil {
+		return "", "", false
+	}
+
+	replacement, ok := replace[obj.Pkg().Path()+"."+obj.Name()]
+	if !ok {
+		return "", "", false
+	}
+
+	i := strings.LastIndex(replacement, ".")
+
+	return replacement[:i], replacement[i+1:], true
+}
+
+// getTypeImportPath returns the import path of a type, or the import path of its replacement.
+func getTypeImportPath(obj *types.TypeName, replace map[string]string) string {
+	if importPath, _, ok := getReplacement(obj, replace); ok {
+		return importPath
+	}
+
+	return obj.Pkg().Path()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseReplacement(t *testing.T) {
+	old, replacement, err := parseReplacement("example.com/lib/internal/b.Skin=example.com/lib/skin.Skin")
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com/lib/internal/b.Skin", old)
+	assert.Equal(t, "example.com/lib/skin.Skin", replacement)
+
+	for _, entry := range []string{
+		"example.com/lib/internal/b.Skin",
+		"example.com/lib/internal/b.Skin=",
+		"example.com/lib/internal/b=example.com/lib/skin.Skin",
+		"example.com/lib/internal/b.Skin=example.com/lib/skin.",
+		".Skin=example.com/lib/skin.Skin",
+	} {
+		_, _, err := parseReplacement(entry)
+		require.Error(t, err, entry)
+	}
+}
+
+func TestOptions_replacements(t *testing.T) {
+	assert.Nil(t, Options{}.replacements())
+
+	opts := Options{Replace: []string{"a/b.Skin=a/c.Skin", "a/b.Seed=a.Seed"}}
+
+	assert.Equal(t, map[string]string{"a/b.Skin": "a/c.Skin", "a/b.Seed": "a.Seed"}, opts.replacements())
+}
+
+func Test_getReplacement(t *testing.T) {
+	pkg := types.NewPackage("example.com/lib/internal/b", "b")
+	skin := types.NewTypeName(token.NoPos, pkg, "Skin", nil)
+	seed := types.NewTypeName(token.NoPos, pkg, "Seed", nil)
+
+	replace := map[string]string{"example.com/lib/internal/b.Skin": "example.com/lib/skin.Peel"}
+
+	importPath, name, ok := getReplacement(skin, replace)
+	require.True(t, ok)
+
+	assert.Equal(t, "example.com/lib/skin", importPath)
+	assert.Equal(t, "Peel", name)
+
+	_, _, ok = getReplacement(seed, replace)
+	assert.False(t, ok)
+
+	assert.Equal(t, "example.com/lib/skin", getTypeImportPath(skin, replace))
+	assert.Equal(t, "example.com/lib/internal/b", getTypeImportPath(seed, replace))
+}
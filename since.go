@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// getChangedDirs returns the directories containing the Go files changed since the git ref:
+// the files modified since the ref (committed or not), and the untracked files.
+// The directories are absolute, only the files under the root are considered.
+func getChangedDirs(ctx context.Context, root, ref string) (map[string]struct{}, error) {
+	dirs := map[string]struct{}{}
+
+	for _, args := range [][]string{
+		{"diff", "--name-only", "--relative", ref, "--"},
+		{"ls-files", "--others", "--exclude-standard"},
+	} {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Dir = root
+
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("command %q: %w", strings.Join(cmd.Args, " "), withStderr(err))
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(out))
+		for scanner.Scan() {
+			name := scanner.Text()
+			if !strings.HasSuffix(name, ".go") {
+				continue
+			}
+
+			dirs[filepath.Dir(filepath.Join(root, filepath.FromSlash(name)))] = struct{}{}
+		}
+	}
+
+	return dirs, nil
+}
+
+// isChangedSince reports whether the mocks of the file must be generated:
+// the directory of the file, or the package of one of the referenced interfaces, has changed.
+func isChangedSince(changed map[string]struct{}, module modInfo, fp string, names []string) bool {
+	if _, ok := changed[filepath.Dir(fp)]; ok {
+		return true
+	}
+
+	for _, name := range names {
+		// The interface name can be prefixed by the path of its package relative to the module root (e.g. `b.Carrot`).
+		index := strings.LastIndex(name, ".")
+		if index <= 0 {
+			continue
+		}
+
+		if _, ok := changed[filepath.Join(module.Dir, filepath.FromSlash(name[:index]))]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withStderr adds the standard error of a failed command to the error.
+func withStderr(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%w: %s", err, bytes.TrimSpace(exitErr.Stderr))
+	}
+
+	return err
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getChangedDirs(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+
+	root := t.TempDir()
+
+	git := func(args ...string) {
+		t.Helper()
+
+		cmd := exec.CommandContext(t.Context(), "git", args...)
+		cmd.Dir = root
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@a", "GIT_COMMITTER_NAME=a", "GIT_COMMITTER_EMAIL=a@a")
+
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+
+	write := func(name, content string) {
+		t.Helper()
+
+		fp := filepath.Join(root, name)
+
+		err := os.MkdirAll(filepath.Dir(fp), 0o750)
+		require.NoError(t, err)
+
+		err = os.WriteFile(fp, []byte(content), 0o600)
+		require.NoError(t, err)
+	}
+
+	git("init", "-q", "-b", "main")
+
+	write("a/a.go", "package a\n")
+	write("b/b.go", "package b\n")
+	write("c/c.go", "package c\n")
+	write("d/readme.md", "d\n")
+
+	git("add", "-A")
+	git("commit", "-q", "-m", "init")
+
+	write("a/a.go", "package a\n\nconst A = 1\n") // modified.
+	write("e/e.go", "package e\n")                // untracked.
+	write("d/readme.md", "dd\n")                  // not a Go file.
+
+	git("add", "a/a.go")
+	git("commit", "-q", "-m", "change")
+
+	dirs, err := getChangedDirs(t.Context(), root, "HEAD~1")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]struct{}{
+		filepath.Join(root, "a"): {},
+		filepath.Join(root, "e"): {},
+	}, dirs)
+
+	_, err = getChangedDirs(t.Context(), root, "unknown-ref")
+	require.ErrorContains(t, err, "unknown-ref")
+}
+
+func Test_isChangedSince(t *testing.T) {
+	module := modInfo{Path: "example.com/root", Dir: "/root"}
+
+	changed := map[string]struct{}{
+		"/root/a":     {},
+		"/root/b/c":   {},
+		"/root/inner": {},
+	}
+
+	testCases := []struct {
+		desc     string
+		fp       string
+		names    []string
+		expected bool
+	}{
+		{desc: "changed directory", fp: "/root/a/mock_test.go", names: []string{"Pineapple"}, expected: true},
+		{desc: "unchanged directory", fp: "/root/d/mock_test.go", names: []string{"Pineapple"}, expected: false},
+		{desc: "changed referenced package", fp: "/root/d/mock_test.go", names: []string{"Pineapple", "b/c.Carrot"}, expected: true},
+		{desc: "unchanged referenced package", fp: "/root/d/mock_test.go", names: []string{"b.Carrot"}, expected: false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, isChangedSince(changed, module, test.fp, test.names))
+		})
+	}
+}
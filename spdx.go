@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// spdxLicenses contains the SPDX identifiers of the most common licenses.
+// https://spdx.org/licenses/
+var spdxLicenses = map[string]struct{}{
+	"0BSD":              {},
+	"AGPL-3.0-only":     {},
+	"AGPL-3.0-or-later": {},
+	"Apache-2.0":        {},
+	"BSD-2-Clause":      {},
+	"BSD-3-Clause":      {},
+	"BSL-1.0":           {},
+	"CC0-1.0":           {},
+	"EPL-2.0":           {},
+	"EUPL-1.2":          {},
+	"GPL-2.0-only":      {},
+	"GPL-2.0-or-later":  {},
+	"GPL-3.0-only":      {},
+	"GPL-3.0-or-later":  {},
+	"ISC":               {},
+	"LGPL-2.1-only":     {},
+	"LGPL-2.1-or-later": {},
+	"LGPL-3.0-only":     {},
+	"LGPL-3.0-or-later": {},
+	"MIT":               {},
+	"MIT-0":             {},
+	"MPL-2.0":           {},
+	"Unlicense":         {},
+	"Zlib":              {},
+}
+
+// validateSPDX checks an SPDX license expression.
+// Only the identifiers combined with `AND` or `OR` are supported (e.g. `MIT OR Apache-2.0`).
+func validateSPDX(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return fmt.Errorf("empty SPDX license expression")
+	}
+
+	for _, field := range strings.Fields(expr) {
+		if field == "AND" || field == "OR" {
+			continue
+		}
+
+		if _, ok := spdxLicenses[field]; !ok {
+			return fmt.Errorf("unknown SPDX license identifier %q", field)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateSPDX(t *testing.T) {
+	testCases := []struct {
+		expr  string
+		valid bool
+	}{
+		{expr: "MIT", valid: true},
+		{expr: "Apache-2.0", valid: true},
+		{expr: "MIT OR Apache-2.0", valid: true},
+		{expr: "GPL-2.0-only AND BSD-3-Clause", valid: true},
+		{expr: " ", valid: false},
+		{expr: "mit", valid: false},
+		{expr: "Foo-1.0", valid: false},
+		{expr: "MIT OR Foo-1.0", valid: false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.expr, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateSPDX(test.expr)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
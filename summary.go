@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Summary contains the counters of a run.
+type Summary struct {
+	Packages   int
+	Interfaces int
+	Methods    int
+	Files      int
+	Elapsed    time.Duration
+}
+
+func (s Summary) String() string {
+	return fmt.Sprintf("%d package(s), %d interface(s), %d method(s), %d file(s) written in %s",
+		s.Packages, s.Interfaces, s.Methods, s.Files, s.Elapsed.Round(time.Millisecond))
+}
+
+// addFile counts a written file and the interfaces and methods it contains.
+func (s *Summary) addFile(pkgDesc PackageDesc) {
+	s.Files++
+	s.Interfaces += len(pkgDesc.Interfaces)
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		s.Methods += len(interfaceDesc.Methods)
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"go/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummary(t *testing.T) {
+	summary := Summary{Packages: 2}
+
+	summary.addFile(PackageDesc{Interfaces: []InterfaceDesc{
+		{Name: "Pineapple", Methods: make([]*types.Func, 3)},
+		{Name: "Coconut", Methods: make([]*types.Func, 2)},
+	}})
+
+	summary.Elapsed = 1234567 * time.Microsecond
+
+	assert.Equal(t, "2 package(s), 2 interface(s), 5 method(s), 1 file(s) written in 1.235s", summary.String())
+}
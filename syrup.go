@@ -3,11 +3,17 @@ package main
 import (
 	"embed"
 	"fmt"
+	"go/token"
 	"go/types"
 	"io"
+	"path"
+	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/ettle/strcase"
 )
@@ -20,6 +26,9 @@ type BaseTemplateData struct {
 	InterfaceName string
 	MethodName    string
 	TypeParamsUse string
+	Receiver      string
+	CallSuffix    string
+	Mock          string // name of the import of testify mock.
 }
 
 // Parameter represents a method parameter with all possible attributes.
@@ -27,6 +36,7 @@ type Parameter struct {
 	Name      string
 	Type      string
 	IsContext bool
+	IsOmitted bool // not a parameter of the On<Method> methods (function parameters with `-func-args=ignore`).
 	Position  int
 }
 
@@ -38,9 +48,10 @@ type Result struct {
 
 // Method represents a method for template generation.
 type Method struct {
-	Name       string
-	Params     []Parameter
-	IsVariadic bool
+	Name        string
+	Params      []Parameter
+	MatchParams []Parameter // Non-context parameters, variadic parameters as slices.
+	IsVariadic  bool
 }
 
 // TypeParamsInfo contains type parameter information for templates.
@@ -53,12 +64,28 @@ type TypeParamsInfo struct {
 type ImportsData struct {
 	Name    string
 	Imports []string
+	SPDX    string
+	Hash    string
+	Banner  []string // comment lines written after the generated code marker.
+	Aliases map[string]string
 }
 
 // MockBaseData contains data for mockBase template.
 type MockBaseData struct {
 	InterfaceName     string
 	ConstructorPrefix string
+	MockBase          string
+	Receiver          string
+	Mock              string // name of the import of testify mock.
+	ContextCheck      bool
+	BareConstructor   bool
+	TestifyStyle      bool
+	Stringer          bool
+	StrictCalls       bool
+	Concurrent        bool     // guard the mock with a mutex serializing the setup of the expectations and the checks before the calls.
+	DebugMethods      bool     // print the calls of the methods when MOCKTAIL_DEBUG is set.
+	ExposeTB          bool     // store the testing interface of the constructor, returned by TB.
+	MustCall          []string // methods asserted as called by a cleanup function.
 	TypeParamsDecl    string
 	TypeParamsUse     string
 }
@@ -69,6 +96,7 @@ type CombinedCallData struct {
 
 	TypeParamsDecl      string
 	ReturnParams        []Parameter
+	ZeroReturnParams    []Parameter // The results before the error, returned as zero values by TypedReturnsError.
 	ReturnsFnSignature  string
 	TypedRunFnSignature string
 	InputParams         []Parameter
@@ -76,6 +104,8 @@ type CombinedCallData struct {
 	CallType            string
 	Methods             []Method
 	HasReturns          bool
+	AnyHelpers          bool
+	ZeroValues          bool // generate the TypedReturnsZero helper.
 }
 
 // CombinedMockMethodData contains all data needed for MockMethod template execution.
@@ -83,11 +113,32 @@ type CombinedMockMethodData struct {
 	BaseTemplateData
 
 	Params      []Parameter
+	MatchParams []Parameter // Non-context parameters, variadic parameters as slices.
 	Results     []Result
 	CallArgs    []string // For _m.Called() and _rf() calls - parameter names.
 	OnCallArgs  []string // For _m.Mock.On() calls - mock.Anything for functions.
+	RawCallArgs []string // For _m.Mock.On() calls of On<Method>Raw - mock.Anything for functions, whatever the func args option.
 	FnSignature string
 	IsVariadic  bool
+	// ContextParam is the name of the context parameter checked by the context check (empty if disabled).
+	ContextParam string
+	AnyHelpers   bool
+	// Stub generates only the method, without the On<Method> helpers.
+	Stub bool
+	// StrictCalls fails the test on the calls without matching expectation.
+	StrictCalls bool
+	// CallCounts generates the Assert<Method>CallCount helper.
+	CallCounts bool
+	// Concurrent serializes the setup of the expectations and the checks before the calls of the method.
+	Concurrent bool
+	// OnceByDefault sets the expectations of the On<Method> helpers to match only once.
+	OnceByDefault bool
+	// DebugMethods prints the calls of the method when MOCKTAIL_DEBUG is set.
+	DebugMethods bool
+	// Stringer serializes the setup of the expectations with the String method of the mock.
+	Stringer bool
+	// ExposeTB generates the assertion helpers using the testing interface of the constructor.
+	ExposeTB bool
 }
 
 // Syrup generates method mocks and mock.Call wrapper.
@@ -98,6 +149,24 @@ type Syrup struct {
 	Signature     *types.Signature
 	TypeParams    *types.TypeParamList
 	Template      *template.Template
+	Receiver      string            // receiver name of the mock methods, `_m` if empty.
+	ContextCheck  bool              // record the calls with a done context.
+	Aliases       map[string]string // aliases of the imports by import path.
+	CallSuffix    string            // suffix of the call wrapper types, `Call` if empty.
+	AnyHelpers    bool              // generate the On<Method>Any helpers.
+	FuncArgs      string            // matching of the function parameters in the On<Method> methods, `anything` if empty.
+	Stub          bool              // generate only the method, without the On<Method> helpers and the call wrapper.
+	StrictCalls   bool              // fail the test on the calls without matching expectation, before calling the mock.
+	ImportNames   []string          // names of the imports of the generated file, never used as parameter names.
+	CallCounts    bool              // generate the Assert<Method>CallCount helpers.
+	ZeroValues    bool              // generate the TypedReturnsZero helpers.
+	Concurrent    bool              // serialize the setup of the expectations and the checks before the calls of the methods.
+	OnceByDefault bool              // set the expectations of the On<Method> helpers to match only once.
+	DebugMethods  bool              // print the calls of the methods when MOCKTAIL_DEBUG is set.
+	Stringer      bool              // serialize the setup of the expectations with the String method of the mock.
+	ExposeTB      bool              // generate the assertion helpers using the testing interface of the constructor.
+	Replace       map[string]string // replacements of the type references, by type (import/path.Type).
+	TestifyAlias  string            // name of the import of testify mock, `mock` if empty.
 }
 
 // Call generates mock.Call wrapper.
@@ -113,7 +182,7 @@ func (s Syrup) Call(writer io.Writer, methods []*types.Func) error {
 		var names []string
 		for i := range s.TypeParams.Len() {
 			tp := s.TypeParams.At(i)
-			params = append(params, tp.Obj().Name()+" "+tp.Constraint().String())
+			params = append(params, tp.Obj().Name()+" "+s.getTypeName(tp.Constraint(), false))
 			names = append(names, tp.Obj().Name())
 		}
 		typeParamsDecl = "[" + strings.Join(params, ", ") + "]"
@@ -123,17 +192,23 @@ func (s Syrup) Call(writer io.Writer, methods []*types.Func) error {
 	// Generate return parameters
 	var returnParams []Parameter
 	hasReturns := results.Len() > 0
-	for i := range results.Len() {
-		rName := string(rune(int('a') + i))
+	for i, rName := range getReturnParamNames(s.getParamNames(params), results, s.ImportNames...) {
 		returnParams = append(returnParams, Parameter{
 			Name: rName,
 			Type: s.getTypeName(results.At(i).Type(), false),
 		})
 	}
 
+	// The methods returning values and an error have a TypedReturnsError helper.
+	var zeroReturnParams []Parameter
+	if n := results.Len(); n > 1 && types.Identical(results.At(n-1).Type(), types.Universe.Lookup("error").Type()) {
+		zeroReturnParams = returnParams[:n-1]
+	}
+
 	// Generate input parameters for TypedRun
 	var inputParams []Parameter
 	var pos int
+	paramNames := s.getParamNames(params)
 	for i := range params.Len() {
 		param := params.At(i)
 		pType := param.Type()
@@ -142,7 +217,7 @@ func (s Syrup) Call(writer io.Writer, methods []*types.Func) error {
 			continue
 		}
 
-		paramName := "_" + getParamName(param, i)
+		paramName := "_" + paramNames[i]
 		inputParams = append(inputParams, Parameter{
 			Name:     paramName,
 			Type:     s.getTypeName(pType, false),
@@ -156,37 +231,44 @@ func (s Syrup) Call(writer io.Writer, methods []*types.Func) error {
 	for _, method := range methods {
 		sign := method.Type().(*types.Signature)
 		mParams := sign.Params()
+		mParamNames := s.getParamNames(mParams)
 
 		var paramData []Parameter
 		for i := range mParams.Len() {
 			param := mParams.At(i)
 			isContext := param.Type().String() == contextType
 
-			name := getParamName(param, i)
+			name := mParamNames[i]
 			paramData = append(paramData, Parameter{
 				Name:      name,
 				Type:      s.getTypeName(param.Type(), i == mParams.Len()-1),
 				IsContext: isContext,
+				IsOmitted: s.isOmittedParam(param),
 			})
 		}
 
 		methodData = append(methodData, Method{
-			Name:       method.Name(),
-			Params:     paramData,
-			IsVariadic: sign.Variadic(),
+			Name:        method.Name(),
+			Params:      paramData,
+			MatchParams: s.getMatchParams(mParams),
+			IsVariadic:  sign.Variadic(),
 		})
 	}
 
-	callType := fmt.Sprintf("%s%sCall%s", strcase.ToGoCamel(s.InterfaceName), s.Method.Name(), typeParamsUse)
+	callType := getCallTypeName(s.InterfaceName, s.Method.Name(), s.getCallSuffix()) + typeParamsUse
 
 	data := CombinedCallData{
 		BaseTemplateData: BaseTemplateData{
 			InterfaceName: s.InterfaceName,
 			MethodName:    s.Method.Name(),
 			TypeParamsUse: typeParamsUse,
+			Receiver:      s.getReceiver(),
+			CallSuffix:    s.getCallSuffix(),
+			Mock:          s.getTestifyAlias(),
 		},
 		TypeParamsDecl:      typeParamsDecl,
 		ReturnParams:        returnParams,
+		ZeroReturnParams:    zeroReturnParams,
 		ReturnsFnSignature:  s.createFuncSignature(params, results),
 		TypedRunFnSignature: s.createFuncSignature(params, nil),
 		InputParams:         inputParams,
@@ -194,6 +276,8 @@ func (s Syrup) Call(writer io.Writer, methods []*types.Func) error {
 		CallType:            callType,
 		Methods:             methodData,
 		HasReturns:          hasReturns,
+		AnyHelpers:          s.AnyHelpers,
+		ZeroValues:          s.ZeroValues,
 	}
 
 	return s.Template.ExecuteTemplate(writer, "combinedCall", data)
@@ -208,6 +292,9 @@ func (s Syrup) MockMethod(writer io.Writer) error {
 	var paramsData []Parameter
 	var callArgs []string   // For _m.Called() and _rf() calls - always use parameter names
 	var onCallArgs []string // For _m.Mock.On() calls - use mock.Anything for functions
+	var rawCallArgs []string
+	var contextParam string // The first context parameter, used by the context check.
+	paramNames := s.getParamNames(params)
 	for i := range params.Len() {
 		param := params.At(i)
 		isContext := param.Type().String() == contextType
@@ -215,15 +302,24 @@ func (s Syrup) MockMethod(writer io.Writer) error {
 		var name string
 		if isContext {
 			name = "_"
+
+			if s.ContextCheck {
+				name = paramNames[i]
+
+				if contextParam == "" {
+					contextParam = name
+				}
+			}
 		} else {
-			name = getParamName(param, i)
+			name = paramNames[i]
 			callArgs = append(callArgs, name)
 
-			// Function parameters use mock.Anything in On calls, others use the parameter name
+			onCallArgs = append(onCallArgs, s.getOnCallArg(param, name))
+
 			if _, ok := param.Type().(*types.Signature); ok {
-				onCallArgs = append(onCallArgs, "mock.Anything")
+				rawCallArgs = append(rawCallArgs, s.getTestifyAlias()+".Anything")
 			} else {
-				onCallArgs = append(onCallArgs, name)
+				rawCallArgs = append(rawCallArgs, name)
 			}
 		}
 
@@ -232,15 +328,16 @@ func (s Syrup) MockMethod(writer io.Writer) error {
 			Name:      name,
 			Type:      s.getTypeName(param.Type(), i == params.Len()-1),
 			IsContext: isContext,
+			IsOmitted: s.isOmittedParam(param),
 		})
 	}
 
 	// Generate result data
 	var resultsData []Result
-	for i := range results.Len() {
+	for i, rName := range getResultNames(paramNames, results, slices.Concat([]string{s.getReceiver(), "_ret"}, s.ImportNames)...) {
 		rType := results.At(i).Type()
 		resultsData = append(resultsData, Result{
-			Name: getResultName(results.At(i), i),
+			Name: rName,
 			Type: s.getTypeName(rType, false),
 		})
 	}
@@ -250,34 +347,91 @@ func (s Syrup) MockMethod(writer io.Writer) error {
 			InterfaceName: s.InterfaceName,
 			MethodName:    s.Method.Name(),
 			TypeParamsUse: s.getTypeParamsUse(),
+			Receiver:      s.getReceiver(),
+			CallSuffix:    s.getCallSuffix(),
+			Mock:          s.getTestifyAlias(),
 		},
-		Params:      paramsData,
-		Results:     resultsData,
-		CallArgs:    callArgs,
-		OnCallArgs:  onCallArgs,
-		FnSignature: s.createFuncSignature(params, results),
-		IsVariadic:  s.Signature.Variadic(),
+		Params:        paramsData,
+		MatchParams:   s.getMatchParams(params),
+		Results:       resultsData,
+		CallArgs:      callArgs,
+		OnCallArgs:    onCallArgs,
+		RawCallArgs:   rawCallArgs,
+		FnSignature:   s.createFuncSignature(params, results),
+		IsVariadic:    s.Signature.Variadic(),
+		ContextParam:  contextParam,
+		AnyHelpers:    s.AnyHelpers,
+		Stub:          s.Stub,
+		StrictCalls:   s.StrictCalls,
+		CallCounts:    s.CallCounts,
+		Concurrent:    s.Concurrent,
+		OnceByDefault: s.OnceByDefault,
+		DebugMethods:  s.DebugMethods,
+		Stringer:      s.Stringer,
+		ExposeTB:      s.ExposeTB,
 	}
 
 	return s.Template.ExecuteTemplate(writer, "combinedMockMethod", data)
 }
 
 // WriteImports generates package imports using the Syrup's template.
-func (s Syrup) WriteImports(writer io.Writer, descPkg PackageDesc) error {
+func (s Syrup) WriteImports(writer io.Writer, descPkg PackageDesc, opts Options) error {
+	name := descPkg.Pkg.Name()
+	switch {
+	case opts.MocksSubPkg:
+		name = mocksSubPackage
+	case opts.TestPackage == testPackageExternal:
+		name += "_test"
+	}
+
 	data := ImportsData{
-		Name:    descPkg.Pkg.Name(),
+		Name:    name,
 		Imports: quickGoImports(descPkg),
+		SPDX:    strings.Join(strings.Fields(opts.SPDX), " "),
+		Hash:    interfacesHash(descPkg, opts, s.Template),
+		Banner:  getBannerLines(opts.Banner),
+		Aliases: s.Aliases,
 	}
 	return s.Template.ExecuteTemplate(writer, "imports", data)
 }
 
+// getBannerLines returns the comment lines of the banner.
+// The lines are separated by newlines, or by `\n` (a flag value cannot contain a newline in a go:generate directive).
+func getBannerLines(banner string) []string {
+	if banner == "" {
+		return nil
+	}
+
+	banner = strings.ReplaceAll(banner, `\n`, "\n")
+
+	var lines []string
+	for line := range strings.Lines(banner) {
+		line = strings.TrimRight(line, " \t\r\n")
+		if line == "" {
+			lines = append(lines, "//")
+			continue
+		}
+
+		lines = append(lines, "// "+line)
+	}
+
+	return lines
+}
+
 // WriteMockBase generates mock base struct and constructor using the Syrup's template.
-func (s Syrup) WriteMockBase(writer io.Writer, interfaceDesc InterfaceDesc, exported bool) error {
+func (s Syrup) WriteMockBase(writer io.Writer, interfaceDesc InterfaceDesc, opts Options) error {
 	constructorPrefix := "new"
-	if exported {
+	if opts.Exported {
 		constructorPrefix = "New"
 	}
 
+	importPath, mockBase := opts.mockBase()
+	if alias, ok := s.Aliases[importPath]; ok {
+		mockBase = alias + "." + mockBase
+	} else if importPath != s.PkgPath {
+		mockBase = path.Base(importPath) + "." + mockBase
+	}
+
 	// Generate type parameter declarations and usage
 	typeParamsDecl := ""
 	typeParamsUse := ""
@@ -286,22 +440,114 @@ func (s Syrup) WriteMockBase(writer io.Writer, interfaceDesc InterfaceDesc, expo
 		var names []string
 		for i := range interfaceDesc.TypeParams.Len() {
 			tp := interfaceDesc.TypeParams.At(i)
-			params = append(params, tp.Obj().Name()+" "+tp.Constraint().String())
+			params = append(params, tp.Obj().Name()+" "+s.getTypeName(tp.Constraint(), false))
 			names = append(names, tp.Obj().Name())
 		}
 		typeParamsDecl = "[" + strings.Join(params, ", ") + "]"
 		typeParamsUse = "[" + strings.Join(names, ", ") + "]"
 	}
 
+	var mustCall []string
+	if opts.Cleanup {
+		mustCall = interfaceDesc.MustCall
+	}
+
 	data := MockBaseData{
 		InterfaceName:     interfaceDesc.Name,
 		ConstructorPrefix: constructorPrefix,
+		MockBase:          mockBase,
+		Receiver:          s.getReceiver(),
+		Mock:              s.getTestifyAlias(),
+		ContextCheck:      opts.ContextCheck,
+		BareConstructor:   opts.BareConstructor,
+		TestifyStyle:      opts.TestifyStyle,
+		Stringer:          opts.Stringer && !hasMethod(interfaceDesc, "String"),
+		StrictCalls:       opts.StrictCalls,
+		Concurrent:        opts.Concurrent,
+		DebugMethods:      opts.DebugMethods,
+		ExposeTB:          opts.ExposeTB,
+		MustCall:          mustCall,
 		TypeParamsDecl:    typeParamsDecl,
 		TypeParamsUse:     typeParamsUse,
 	}
 	return s.Template.ExecuteTemplate(writer, "mockBase", data)
 }
 
+// getMatchParams returns the non-context parameters with the types used by the matchers.
+// The variadic parameters are received as slices by the matchers.
+func (s Syrup) getMatchParams(params *types.Tuple) []Parameter {
+	var matchParams []Parameter
+	paramNames := s.getParamNames(params)
+	for i := range params.Len() {
+		param := params.At(i)
+		if param.Type().String() == contextType {
+			continue
+		}
+
+		matchParams = append(matchParams, Parameter{
+			Name:     paramNames[i],
+			Type:     s.getTypeName(param.Type(), false),
+			Position: len(matchParams),
+		})
+	}
+
+	return matchParams
+}
+
+// getReceiver returns the receiver name of the mock methods.
+func (s Syrup) getReceiver() string {
+	if s.Receiver == "" {
+		return defaultReceiver
+	}
+
+	return s.Receiver
+}
+
+// getOnCallArg returns the argument of `Mock.On` for a parameter.
+// Functions are not comparable: by default, the function parameters use mock.Anything.
+func (s Syrup) getOnCallArg(param *types.Var, name string) string {
+	if _, ok := param.Type().(*types.Signature); !ok {
+		return name
+	}
+
+	if s.FuncArgs == funcArgsName {
+		typ := s.getTypeName(param.Type(), false)
+
+		return fmt.Sprintf("%s.MatchedBy(func(_f %s) bool { return reflect.ValueOf(_f).Pointer() == reflect.ValueOf(%s).Pointer() })", s.getTestifyAlias(), typ, name)
+	}
+
+	return s.getTestifyAlias() + ".Anything"
+}
+
+// isOmittedParam reports whether the parameter is omitted from the On<Method> methods.
+func (s Syrup) isOmittedParam(param *types.Var) bool {
+	_, ok := param.Type().(*types.Signature)
+
+	return ok && s.FuncArgs == funcArgsIgnore
+}
+
+// getTestifyAlias returns the name of the import of testify mock.
+func (s Syrup) getTestifyAlias() string {
+	if s.TestifyAlias == "" {
+		return defaultTestifyAlias
+	}
+
+	return s.TestifyAlias
+}
+
+func (s Syrup) getCallSuffix() string {
+	if s.CallSuffix == "" {
+		return defaultCallSuffix
+	}
+
+	return s.CallSuffix
+}
+
+// getCallTypeName returns the name (without type parameters) of the call wrapper type of a method.
+func getCallTypeName(interfaceName, methodName, suffix string) string {
+	return strcase.ToGoCamel(interfaceName) + methodName + suffix
+}
+
 // getTypeParamsUse returns type parameters for usage in method receivers.
 func (s Syrup) getTypeParamsUse() string {
 	if s.TypeParams == nil || s.TypeParams.Len() == 0 {
@@ -319,6 +565,11 @@ func (s Syrup) getTypeParamsUse() string {
 func (s Syrup) getTypeName(t types.Type, last bool) string {
 	switch v := t.(type) {
 	case *types.Basic:
+		// The name of unsafe.Pointer is `Pointer`.
+		if v.Kind() == types.UnsafePointer {
+			return "unsafe.Pointer"
+		}
+
 		return v.Name()
 
 	case *types.Slice:
@@ -341,16 +592,21 @@ func (s Syrup) getTypeName(t types.Type, last bool) string {
 		return v.String()
 
 	case *types.Interface:
-		return v.String()
-
-	case *types.Signature:
-		fn := "func(" + strings.Join(s.getTupleTypes(v.Params()), ",") + ")"
-
-		if v.Results().Len() > 0 {
-			fn += " (" + strings.Join(s.getTupleTypes(v.Results()), ",") + ")"
+		return s.getInterfaceTypeName(v)
+
+	case *types.Union:
+		terms := make([]string, v.Len())
+		for i := range v.Len() {
+			terms[i] = s.getTypeName(v.Term(i).Type(), false)
+			if v.Term(i).Tilde() {
+				terms[i] = "~" + terms[i]
+			}
 		}
 
-		return fn
+		return strings.Join(terms, " | ")
+
+	case *types.Signature:
+		return "func" + s.getSignatureTypeName(v)
 
 	case *types.Chan:
 		return s.getChanTypeName(v)
@@ -361,6 +617,19 @@ func (s Syrup) getTypeName(t types.Type, last bool) string {
 	case *types.TypeParam:
 		return v.Obj().Name()
 
+	case *types.Alias:
+		// The predeclared aliases (any) and the exported aliases are kept as written (e.g. `foo.ID`, not `bar.Identifier`).
+		// The unexported aliases are rendered as the aliased type: they are not visible from another package.
+		if v.Obj().Pkg() == nil {
+			return v.Obj().Name()
+		}
+
+		if v.Obj().Exported() {
+			return s.getQualifiedName(v.Obj(), v.TypeArgs())
+		}
+
+		return s.getTypeName(types.Unalias(v), last)
+
 	default:
 		panic(fmt.Sprintf("OOPS %[1]T %[1]s", t))
 	}
@@ -377,10 +646,7 @@ func (s Syrup) getTupleTypes(t *types.Tuple) []string {
 
 func (s Syrup) getNamedTypeName(t *types.Named) string {
 	if t.Obj() != nil && t.Obj().Pkg() != nil {
-		if t.Obj().Pkg().Path() == s.PkgPath {
-			return t.Obj().Name()
-		}
-		return t.Obj().Pkg().Name() + "." + t.Obj().Name()
+		return s.getQualifiedName(t.Obj(), t.TypeArgs())
 	}
 
 	name := t.String()
@@ -392,6 +658,88 @@ func (s Syrup) getNamedTypeName(t *types.Named) string {
 	return name
 }
 
+// getSignatureTypeName returns the parameters and the results of a signature (e.g. `(string, ...int) (bool,error)`).
+func (s Syrup) getSignatureTypeName(sig *types.Signature) string {
+	params := s.getTupleTypes(sig.Params())
+
+	// The variadic parameter of an inner function is the last one.
+	if sig.Variadic() {
+		last := sig.Params().At(sig.Params().Len() - 1)
+		if slice, ok := last.Type().(*types.Slice); ok {
+			params[len(params)-1] = "..." + s.getTypeName(slice.Elem(), false)
+		}
+	}
+
+	fn := "(" + strings.Join(params, ",") + ")"
+
+	if sig.Results().Len() > 0 {
+		fn += " (" + strings.Join(s.getTupleTypes(sig.Results()), ",") + ")"
+	}
+
+	return fn
+}
+
+// getInterfaceTypeName returns an anonymous interface (e.g. `interface{ io.Closer; Read([]byte) (int,error) }`).
+// The embedded types and the method signatures are qualified like the other types of the generated file.
+// The empty interface is rendered as `any`, however it's written.
+func (s Syrup) getInterfaceTypeName(iface *types.Interface) string {
+	var elems []string
+
+	for embedded := range iface.EmbeddedTypes() {
+		elems = append(elems, s.getTypeName(embedded, false))
+	}
+
+	for method := range iface.ExplicitMethods() {
+		elems = append(elems, method.Name()+s.getSignatureTypeName(method.Signature()))
+	}
+
+	if len(elems) == 0 {
+		return "any"
+	}
+
+	return "interface{ " + strings.Join(elems, "; ") + " }"
+}
+
+// getQualifiedName returns the name of a type (named or alias) with its type arguments,
+// qualified by its package name (or the alias of the import) outside of its package.
+func (s Syrup) getQualifiedName(obj *types.TypeName, args *types.TypeList) string {
+	if importPath, typeName, ok := getReplacement(obj, s.Replace); ok {
+		name := typeName + s.getTypeArgs(args)
+
+		if importPath == s.PkgPath {
+			return name
+		}
+		if alias, ok := s.Aliases[importPath]; ok {
+			return alias + "." + name
+		}
+		return path.Base(importPath) + "." + name
+	}
+
+	name := obj.Name() + s.getTypeArgs(args)
+
+	if obj.Pkg().Path() == s.PkgPath {
+		return name
+	}
+	if alias, ok := s.Aliases[obj.Pkg().Path()]; ok {
+		return alias + "." + name
+	}
+	return obj.Pkg().Name() + "." + name
+}
+
+// getTypeArgs returns the type arguments of a generic type instantiation (e.g. `[string, Water]`).
+func (s Syrup) getTypeArgs(args *types.TypeList) string {
+	if args.Len() == 0 {
+		return ""
+	}
+
+	var names []string
+	for arg := range args.Types() {
+		names = append(names, s.getTypeName(arg, false))
+	}
+
+	return "[" + strings.Join(names, ", ") + "]"
+}
+
 func (s Syrup) getChanTypeName(t *types.Chan) string {
 	var typ string
 	switch t.Dir() {
@@ -438,58 +786,212 @@ func (s Syrup) createFuncSignature(params, results *types.Tuple) string {
 }
 
 func quickGoImports(descPkg PackageDesc) []string {
-	imports := []string{
-		"", // to separate std imports than the others
+	descPkg.Imports["time"] = struct{}{}         // require by `WaitUntil(w <-chan time.Time)`
+	descPkg.Imports[testifyMockPkg] = struct{}{} // require by mock
+
+	return groupImports(descPkg.Imports)
+}
+
+// groupImports sorts the imports: the standard library imports first, then the others.
+// An empty string separates the 2 groups, only if both groups are not empty.
+func groupImports(imports map[string]struct{}) []string {
+	var std, others []string
+
+	for imp := range imports {
+		switch {
+		case imp == "":
+			continue
+		case isStdImport(imp):
+			std = append(std, imp)
+		default:
+			others = append(others, imp)
+		}
+	}
+
+	sort.Strings(std)
+	sort.Strings(others)
+
+	if len(std) == 0 || len(others) == 0 {
+		return append(std, others...)
+	}
+
+	return slices.Concat(std, []string{""}, others)
+}
+
+// isStdImport reports whether the import path looks like a standard library package.
+// Like goimports, an import path without dot in its first element is considered as a standard library package.
+func isStdImport(imp string) bool {
+	first, _, _ := strings.Cut(imp, "/")
+
+	return !strings.Contains(first, ".")
+}
+
+func getParamName(tVar *types.Var, i int) string {
+	if tVar.Name() == "" {
+		return fmt.Sprintf("%sParam", getLetterName(i))
+	}
+	return tVar.Name()
+}
+
+// getParamNames returns the names of the parameters of a mock method.
+// A parameter named like an import of the generated file would shadow the package in the method body:
+// it is suffixed with underscores until the name is free (e.g. `time` becomes `time_`).
+func (s Syrup) getParamNames(params *types.Tuple) []string {
+	taken := map[string]struct{}{}
+	for _, name := range s.ImportNames {
+		taken[name] = struct{}{}
+	}
+
+	names := make([]string, params.Len())
+	for i := range params.Len() {
+		names[i] = getParamName(params.At(i), i)
+	}
+
+	for _, name := range names {
+		if !slices.Contains(s.ImportNames, name) {
+			taken[name] = struct{}{}
+		}
+	}
+
+	for i, name := range names {
+		if !slices.Contains(s.ImportNames, name) {
+			continue
+		}
+
+		for {
+			name += "_"
+
+			if _, ok := taken[name]; !ok {
+				break
+			}
+		}
+
+		taken[name] = struct{}{}
+		names[i] = name
 	}
 
-	descPkg.Imports["testing"] = struct{}{}                          // require by test
-	descPkg.Imports["time"] = struct{}{}                             // require by `WaitUntil(w <-chan time.Time)`
-	descPkg.Imports["github.com/stretchr/testify/mock"] = struct{}{} // require by mock
+	return names
+}
 
-	for imp := range descPkg.Imports {
-		imports = append(imports, imp)
+// getResultNames returns the names of the result variables of a mock method.
+// The names never collide with each other, with the names of the method parameters, or with the reserved names.
+func getResultNames(paramNames []string, results *types.Tuple, reserved ...string) []string {
+	taken := map[string]struct{}{}
+	for _, name := range slices.Concat(paramNames, reserved) {
+		taken[name] = struct{}{}
 	}
 
-	sort.Slice(imports, func(i, j int) bool {
-		if imports[i] == "" {
-			return strings.Contains(imports[j], ".")
+	names := make([]string, results.Len())
+
+	// The names of the named results are kept when possible.
+	for i := range results.Len() {
+		name := results.At(i).Name()
+		if name == "" || name == "_" {
+			continue
 		}
-		if imports[j] == "" {
-			return !strings.Contains(imports[i], ".")
+
+		if _, ok := taken[name]; ok {
+			continue
 		}
 
-		if strings.Contains(imports[i], ".") && !strings.Contains(imports[j], ".") {
-			return false
+		taken[name] = struct{}{}
+		names[i] = name
+	}
+
+	for i := range names {
+		if names[i] != "" {
+			continue
 		}
-		if !strings.Contains(imports[i], ".") && strings.Contains(imports[j], ".") {
-			return true
+
+		name := fmt.Sprintf("_r%s%d", getLetterName(i), i)
+		for {
+			if _, ok := taken[name]; !ok {
+				break
+			}
+
+			name += "_"
 		}
 
-		return imports[i] < imports[j]
-	})
+		taken[name] = struct{}{}
+		names[i] = name
+	}
 
-	return imports
+	return names
 }
 
-func getParamName(tVar *types.Var, i int) string {
-	if tVar.Name() == "" {
-		return fmt.Sprintf("%sParam", string(rune('a'+i)))
+// getReturnParamNames returns the parameter names used by `TypedReturns`.
+// The names never collide with the names of the method parameters, or with the reserved names.
+func getReturnParamNames(paramNames []string, results *types.Tuple, reserved ...string) []string {
+	taken := map[string]struct{}{}
+	for _, name := range slices.Concat(paramNames, reserved) {
+		taken[name] = struct{}{}
 	}
-	return tVar.Name()
+
+	var names []string
+	for i := 0; len(names) < results.Len(); i++ {
+		name := getLetterName(i)
+
+		if _, ok := taken[name]; ok || token.IsKeyword(name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names
 }
 
-func getResultName(tVar *types.Var, i int) string {
-	if tVar.Name() == "" {
-		return fmt.Sprintf("_r%s%d", string(rune('a'+i)), i)
+// getLetterName returns a name based on the index: a, b, ..., z, aa, ab, ...
+func getLetterName(i int) string {
+	var name string
+	for ; i >= 0; i = i/26 - 1 {
+		name = string(rune('a'+i%26)) + name
 	}
-	return tVar.Name()
+
+	return name
+}
+
+// templateFuncs are the functions available to the templates, including the custom templates.
+// The functions keep the argument order of their Go counterparts (e.g. `{{ TrimPrefix .MethodName "Get" }}`).
+var templateFuncs = template.FuncMap{
+	// Case conversions (the Go variants keep the initialisms, e.g. `userID`).
+	"ToGoCamel":  strcase.ToGoCamel,
+	"ToGoPascal": strcase.ToGoPascal,
+	"ToGoSnake":  strcase.ToGoSnake,
+	"ToGoKebab":  strcase.ToGoKebab,
+	"ToCamel":    strcase.ToCamel,
+	"ToPascal":   strcase.ToPascal,
+	"ToSnake":    strcase.ToSnake,
+	"ToKebab":    strcase.ToKebab,
+	"Title":      title,
+
+	// String helpers.
+	"ToLower":    strings.ToLower,
+	"ToUpper":    strings.ToUpper,
+	"TrimPrefix": strings.TrimPrefix,
+	"TrimSuffix": strings.TrimSuffix,
+	"TrimSpace":  strings.TrimSpace,
+	"HasPrefix":  strings.HasPrefix,
+	"HasSuffix":  strings.HasSuffix,
+	"Contains":   strings.Contains,
+	"Replace":    strings.ReplaceAll,
+	"Split":      strings.Split,
+	"Join":       strings.Join,
+	"Repeat":     strings.Repeat,
+}
+
+// title returns the string with its first letter in upper case (e.g. `userID` -> `UserID`).
+func title(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+
+	return string(unicode.ToUpper(r)) + s[size:]
 }
 
 func getTemplate(templateFile string) (*template.Template, error) {
-	base := template.New("templates").Funcs(template.FuncMap{
-		"ToGoCamel":  strcase.ToGoCamel,
-		"ToGoPascal": strcase.ToGoPascal,
-	})
+	base := template.New("templates").Funcs(templateFuncs)
 
 	if templateFile != "" {
 		// Use custom template file
@@ -499,3 +1001,29 @@ func getTemplate(templateFile string) (*template.Template, error) {
 	// Use embedded template
 	return base.ParseFS(templatesFS, "templates.go.tmpl")
 }
+
+// requiredTemplates are the templates executed by the generation.
+var requiredTemplates = []string{"imports", "mockBase", "combinedCall", "combinedMockMethod"}
+
+// getTemplateDir parses the template fragments (`*.tmpl`) of a directory,
+// which must define together all the templates executed by the generation.
+func getTemplateDir(templateDir string) (*template.Template, error) {
+	tmpl, err := template.New("templates").Funcs(templateFuncs).ParseGlob(filepath.Join(templateDir, "*.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+
+	for _, name := range requiredTemplates {
+		if tmpl.Lookup(name) == nil {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("the templates of %s don't define: %s", templateDir, strings.Join(missing, ", "))
+	}
+
+	return tmpl, nil
+}
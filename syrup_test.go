@@ -2,7 +2,11 @@ package main
 
 import (
 	"bytes"
+	"go/token"
 	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"text/template"
 
@@ -267,6 +271,85 @@ func (_m *{{ .InterfaceName | ToGoCamel }}Mock) {{ .MethodName }}() { _m.Called(
 	}
 }
 
+func Test_getTemplate_funcs(t *testing.T) {
+	t.Parallel()
+
+	templateFile := filepath.Join(t.TempDir(), "custom.go.tmpl")
+
+	custom := `{{define "name"}}{{ ToSnake .MethodName }} {{ TrimPrefix .MethodName "Get" }} {{ Title .InterfaceName }} {{ ToUpper .InterfaceName }}{{end}}`
+
+	err := os.WriteFile(templateFile, []byte(custom), 0o600)
+	require.NoError(t, err)
+
+	tmpl, err := getTemplate(templateFile)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+
+	err = tmpl.ExecuteTemplate(&buffer, "name", BaseTemplateData{InterfaceName: "userRepository", MethodName: "GetUserID"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "get_user_id UserID UserRepository USERREPOSITORY", buffer.String())
+}
+
+func Test_getTemplateDir(t *testing.T) {
+	t.Parallel()
+
+	templateDir := t.TempDir()
+
+	fragments := map[string]string{
+		"imports.tmpl": `{{define "imports"}}package {{ .Name }}{{end}}`,
+		"mock.tmpl":    `{{define "mockBase"}}type {{ ToGoCamel .InterfaceName }}Mock struct{}{{end}}{{define "combinedMockMethod"}}{{end}}`,
+		"call.tmpl":    `{{define "combinedCall"}}{{end}}`,
+		"notes.txt":    `{{define "combinedCall"}}ignored{{end}}`,
+	}
+
+	for name, content := range fragments {
+		err := os.WriteFile(filepath.Join(templateDir, name), []byte(content), 0o600)
+		require.NoError(t, err)
+	}
+
+	tmpl, err := getTemplateDir(templateDir)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+
+	err = tmpl.ExecuteTemplate(&buffer, "mockBase", MockBaseData{InterfaceName: "UserRepository"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "type userRepositoryMock struct{}", buffer.String())
+
+	buffer.Reset()
+
+	err = tmpl.ExecuteTemplate(&buffer, "combinedCall", nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, buffer.String())
+}
+
+func Test_getTemplateDir_missing(t *testing.T) {
+	t.Parallel()
+
+	templateDir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(templateDir, "imports.tmpl"), []byte(`{{define "imports"}}{{end}}{{define "combinedCall"}}{{end}}`), 0o600)
+	require.NoError(t, err)
+
+	_, err = getTemplateDir(templateDir)
+	require.EqualError(t, err, "the templates of "+templateDir+" don't define: mockBase, combinedMockMethod")
+
+	_, err = getTemplateDir(t.TempDir())
+	require.Error(t, err)
+}
+
+func Test_title(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "UserID", title("userID"))
+	assert.Equal(t, "Élan", title("élan"))
+	assert.Empty(t, title(""))
+}
+
 func TestSyrup_TemplateErrorHandling(t *testing.T) {
 	t.Parallel()
 	errorTemplates := map[string]string{
@@ -370,3 +453,864 @@ func TestSyrup_TemplateErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func Test_getReturnParamNames(t *testing.T) {
+	t.Parallel()
+
+	stringType := types.Typ[types.String]
+
+	var vars []*types.Var
+	for range 30 {
+		vars = append(vars, types.NewParam(0, nil, "", stringType))
+	}
+
+	names := getReturnParamNames([]string{"a", "c", "cParam"}, types.NewTuple(vars...))
+
+	require.Len(t, names, 30)
+	assert.Equal(t, []string{"b", "d", "e"}, names[:3])
+	assert.Equal(t, []string{"z", "aa", "ab", "ac", "ad", "ae", "af"}, names[23:])
+
+	// The reserved names (e.g. the imports of the generated file) are skipped.
+	names = getReturnParamNames([]string{"a", "c"}, types.NewTuple(vars[:2]...), "d")
+	assert.Equal(t, []string{"b", "e"}, names)
+}
+
+func TestSyrup_MockMethod_receiver(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.Receiver = "m"
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (m *userRepositoryMock) GetUser(")
+	assert.Contains(t, output, "_ret := m.Called(id, active)")
+	assert.Contains(t, output, "Parent: m}")
+	assert.NotContains(t, output, "_m")
+}
+
+func TestSyrup_Call_callSuffix(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.CallSuffix = "Expectation"
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	err = syrup.Call(&buffer, createSimpleTestMethods())
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "type userRepositoryGetUserExpectation struct{")
+	assert.Contains(t, output, ") *userRepositoryGetUserExpectation {")
+	assert.Contains(t, output, "func (_c *userRepositoryGetUserExpectation) OnFindByName(name string) *userRepositoryFindByNameExpectation {")
+	assert.NotContains(t, output, "GetUserCall")
+}
+
+func TestSyrup_anyHelpers(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.AnyHelpers = true
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	err = syrup.Call(&buffer, createSimpleTestMethods())
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (_m *userRepositoryMock) OnGetUserAny() *userRepositoryGetUserCall {")
+	assert.Contains(t, output, `_m.Mock.On("GetUser", mock.Anything, mock.Anything)`)
+	assert.Contains(t, output, "func (_c *userRepositoryGetUserCall) OnFindByNameAny() *userRepositoryFindByNameCall {")
+}
+
+func TestSyrup_callCounts(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.CallCounts = true
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (_m *userRepositoryMock) AssertGetUserCallCount(tb mock.TestingT, expectedCalls int) bool {")
+	assert.Contains(t, output, `return _m.AssertNumberOfCalls(tb, "GetUser", expectedCalls)`)
+}
+
+func TestSyrup_callCounts_exposeTB(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.CallCounts = true
+	syrup.ContextCheck = true
+	syrup.ExposeTB = true
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (_m *userRepositoryMock) AssertGetUserCallCount(expectedCalls int) bool {")
+	assert.Contains(t, output, `return _m.AssertNumberOfCalls(_m._tb, "GetUser", expectedCalls)`)
+	assert.Contains(t, output, "func (_m *userRepositoryMock) AssertGetUserContextLive() bool {")
+	assert.Contains(t, output, `return _m._assertContextLive(_m._tb, "GetUser")`)
+}
+
+func TestSyrup_zeroValues(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+
+	var buffer bytes.Buffer
+	err := syrup.Call(&buffer, createSimpleTestMethods())
+	require.NoError(t, err)
+
+	assert.NotContains(t, buffer.String(), "TypedReturnsZero")
+
+	syrup.ZeroValues = true
+
+	buffer.Reset()
+	err = syrup.Call(&buffer, createSimpleTestMethods())
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (_c *userRepositoryGetUserCall) TypedReturnsZero() *userRepositoryGetUserCall {")
+	assert.Contains(t, output, "\tvar a *User\n\tvar b error\n\n\t_c.Call = _c.Return(a, b)\n")
+}
+
+func TestSyrup_concurrent(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.Concurrent = true
+
+	var buffer bytes.Buffer
+	err := syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository"}, Options{Concurrent: true})
+	require.NoError(t, err)
+
+	err = syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "_callsMu sync.Mutex")
+	assert.Contains(t, output, "func (_m *userRepositoryMock) GetUser(_ context.Context, id string, active bool) (*User, error) {\n\t_ret := _m.Called(id, active)\n")
+	assert.Contains(t, output, "func (_m *userRepositoryMock) OnGetUser(id string, active bool) *userRepositoryGetUserCall {\n\t_m._callsMu.Lock()\n\tdefer _m._callsMu.Unlock()\n")
+
+	// The checks before the call are locked, the call of testify is not.
+	syrup.StrictCalls = true
+
+	buffer.Reset()
+	err = syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	assert.Contains(t, buffer.String(), "\tfunc() {\n\t\t_m._callsMu.Lock()\n\t\tdefer _m._callsMu.Unlock()\n\n\t_m._assertExpected(\"GetUser\", id, active)\n}()\n\n\t_ret := _m.Called(id, active)\n")
+}
+
+func TestSyrup_onceByDefault(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.AnyHelpers = true
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	assert.NotContains(t, buffer.String(), ".Once()")
+
+	syrup.OnceByDefault = true
+
+	buffer.Reset()
+	err = syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, `Call: _m.Mock.On("GetUser", id, active).Once(), Parent: _m}`)
+	assert.Contains(t, output, `Call: _m.Mock.On("GetUser", _args...).Once(), Parent: _m}`)
+	assert.Contains(t, output, `Call: _m.Mock.On("GetUser", mock.Anything, mock.Anything).Once(), Parent: _m}`)
+}
+
+func TestSyrup_debugMethods(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.DebugMethods = true
+
+	var buffer bytes.Buffer
+	err := syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository"}, Options{DebugMethods: true})
+	require.NoError(t, err)
+
+	err = syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "DebugWriter io.Writer")
+	assert.Contains(t, output, "func (_m *userRepositoryMock) _debugCall(method string, args ...interface{}) {")
+	assert.Contains(t, output, `os.Getenv("MOCKTAIL_DEBUG")`)
+	assert.Contains(t, output, "\t_m._debugCall(\"GetUser\", id, active)\n\n\t_ret := _m.Called(id, active)")
+}
+
+func TestSyrup_exposeTB(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+
+	var buffer bytes.Buffer
+	err := syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository"}, Options{ExposeTB: true})
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "_tb testing.TB")
+	assert.Contains(t, output, "m._tb = tb")
+	assert.Contains(t, output, "func (_m *userRepositoryMock) TB() testing.TB {\n\treturn _m._tb\n}")
+
+	buffer.Reset()
+	err = syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository"}, Options{ExposeTB: true, StrictCalls: true, TestifyStyle: true})
+	require.NoError(t, err)
+
+	output = buffer.String()
+	assert.Equal(t, 1, strings.Count(output, "_tb mock.TestingT"))
+	assert.Contains(t, output, "func (_m *userRepositoryMock) TB() mock.TestingT {")
+}
+
+func TestSyrup_MockMethod_stub(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.Stub = true
+	syrup.AnyHelpers = true
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (_m *userRepositoryMock) GetUser(")
+	assert.Contains(t, output, "_m.Called(")
+	assert.NotContains(t, output, "OnGetUser")
+}
+
+func TestSyrup_strictCalls(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.StrictCalls = true
+
+	var buffer bytes.Buffer
+	err := syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository"}, Options{StrictCalls: true})
+	require.NoError(t, err)
+
+	err = syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "_tb mock.TestingT")
+	assert.Contains(t, output, "m._tb = tb")
+	assert.Contains(t, output, "func (_m *userRepositoryMock) _assertExpected(method string, args ...interface{}) {")
+
+	// The expectations are read with the mutex held, like the setup of the expectations.
+	assert.Contains(t, output, "_callsMu sync.Mutex")
+	assert.Contains(t, output, "\tfunc() {\n\t\t_m._callsMu.Lock()\n\t\tdefer _m._callsMu.Unlock()\n\n\t_m._assertExpected(\"GetUser\", id, active)\n}()\n\n\t_ret := _m.Called(id, active)")
+	assert.Contains(t, output, "func (_m *userRepositoryMock) OnGetUser(id string, active bool) *userRepositoryGetUserCall {\n\t_m._callsMu.Lock()\n\tdefer _m._callsMu.Unlock()\n")
+}
+
+func TestSyrup_Call_typedReturnsError(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+
+	var buffer bytes.Buffer
+	err := syrup.Call(&buffer, createSimpleTestMethods())
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (_c *userRepositoryGetUserCall) TypedReturnsError(err error) *userRepositoryGetUserCall {\n\tvar a *User\n\n\t_c.Call = _c.Return(a, err)")
+}
+
+func TestSyrup_MockMethod_contextOnly(t *testing.T) {
+	t.Parallel()
+
+	contextType := types.NewNamed(types.NewTypeName(0, types.NewPackage("context", "context"), "Context", nil), types.NewInterfaceType(nil, nil), nil)
+	errorType := types.Universe.Lookup("error").Type()
+
+	signature := types.NewSignatureType(nil, nil, nil,
+		types.NewTuple(types.NewParam(0, nil, "ctx", contextType)),
+		types.NewTuple(types.NewParam(0, nil, "", errorType)),
+		false)
+
+	syrup := createTestSyrup(t, "")
+	syrup.Method = types.NewFunc(0, nil, "Ping", signature)
+	syrup.Signature = signature
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (_m *userRepositoryMock) Ping(_ context.Context) error {")
+	assert.Contains(t, output, "_ret := _m.Called()")
+	assert.Contains(t, output, "func (_m *userRepositoryMock) OnPing() *userRepositoryPingCall {")
+	assert.Contains(t, output, `_m.Mock.On("Ping")`)
+	assert.NotContains(t, output, "OnPingMatched")
+}
+
+func TestSyrup_Call_noReturns(t *testing.T) {
+	t.Parallel()
+
+	intSlice := types.NewSlice(types.Typ[types.Int])
+
+	signature := types.NewSignatureType(nil, nil, nil,
+		types.NewTuple(types.NewParam(0, nil, "changes", types.NewSlice(types.Typ[types.String])), types.NewParam(0, nil, "opts", intSlice)),
+		nil,
+		true)
+
+	syrup := createTestSyrup(t, "")
+	syrup.Method = types.NewFunc(0, nil, "Apply", signature)
+	syrup.Signature = signature
+
+	var buffer bytes.Buffer
+	err := syrup.Call(&buffer, []*types.Func{syrup.Method})
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.NotContains(t, output, "TypedReturns")
+	assert.NotContains(t, output, "ReturnsFn")
+	assert.Contains(t, output, "func (_c *userRepositoryApplyCall) Once() *userRepositoryApplyCall {")
+	assert.Contains(t, output, "func (_c *userRepositoryApplyCall) Maybe() *userRepositoryApplyCall {")
+	assert.Contains(t, output, "func (_c *userRepositoryApplyCall) After(d time.Duration) *userRepositoryApplyCall {\n\t_c.Call = _c.Call.After(d)")
+	assert.Contains(t, output, "func (_c *userRepositoryApplyCall) TypedRun(fn func([]string, ...int) ) *userRepositoryApplyCall {")
+	assert.Contains(t, output, "fn(_changes, _opts...)")
+}
+
+func TestSyrup_MockMethod_funcArgs(t *testing.T) {
+	fnType := types.NewSignatureType(nil, nil, nil, types.NewTuple(types.NewParam(0, nil, "", types.Typ[types.String])), nil, false)
+
+	signature := types.NewSignatureType(nil, nil, nil,
+		types.NewTuple(types.NewParam(0, nil, "name", types.Typ[types.String]), types.NewParam(0, nil, "fn", fnType)),
+		nil,
+		false)
+
+	testCases := []struct {
+		funcArgs string
+		expected []string
+	}{
+		{
+			funcArgs: "",
+			expected: []string{
+				"OnWalk(name string, fn func(string)) *userRepositoryWalkCall {",
+				`_m.Mock.On("Walk", name, mock.Anything)`,
+			},
+		},
+		{
+			funcArgs: funcArgsIgnore,
+			expected: []string{
+				"OnWalk(name string) *userRepositoryWalkCall {",
+				`_m.Mock.On("Walk", name, mock.Anything)`,
+				"func (_c *userRepositoryWalkCall) OnWalk(name string) *userRepositoryWalkCall {",
+				"return _c.Parent.OnWalk(name)",
+			},
+		},
+		{
+			funcArgs: funcArgsName,
+			expected: []string{
+				"OnWalk(name string, fn func(string)) *userRepositoryWalkCall {",
+				`mock.MatchedBy(func(_f func(string)) bool { return reflect.ValueOf(_f).Pointer() == reflect.ValueOf(fn).Pointer() })`,
+				`OnWalkRaw(name interface{}, fn interface{}) *userRepositoryWalkCall {` + "\n\t" + `return &userRepositoryWalkCall{Call: _m.Mock.On("Walk", name, mock.Anything)`,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.funcArgs, func(t *testing.T) {
+			t.Parallel()
+
+			syrup := createTestSyrup(t, "")
+			syrup.Method = types.NewFunc(0, nil, "Walk", signature)
+			syrup.Signature = signature
+			syrup.FuncArgs = test.funcArgs
+
+			var buffer bytes.Buffer
+			err := syrup.MockMethod(&buffer)
+			require.NoError(t, err)
+
+			err = syrup.Call(&buffer, []*types.Func{syrup.Method})
+			require.NoError(t, err)
+
+			output := buffer.String()
+			for _, expected := range test.expected {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestSyrup_MockMethod_contextCheck(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+	syrup.ContextCheck = true
+
+	var buffer bytes.Buffer
+	err := syrup.MockMethod(&buffer)
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "GetUser(ctx context.Context, id string, active bool)")
+	assert.Contains(t, output, `_m._recordDoneContext("GetUser")`)
+	assert.Contains(t, output, "func (_m *userRepositoryMock) AssertGetUserContextLive(tb testing.TB) bool {")
+	assert.Contains(t, output, "_ret := _m.Called(id, active)")
+}
+
+func TestSyrup_getTypeName_typeArgs(t *testing.T) {
+	t.Parallel()
+
+	pkg := types.NewPackage("myapp", "myapp")
+	userPkg := types.NewPackage("example.com/user", "user")
+
+	tparam := types.NewTypeParam(types.NewTypeName(token.NoPos, pkg, "T", nil), types.Universe.Lookup("any").Type())
+	page := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Page", nil), types.NewStruct(nil, nil), nil)
+	page.SetTypeParams([]*types.TypeParam{tparam})
+
+	user := types.NewNamed(types.NewTypeName(token.NoPos, userPkg, "User", nil), types.NewStruct(nil, nil), nil)
+
+	inst, err := types.Instantiate(nil, page, []types.Type{user}, true)
+	require.NoError(t, err)
+
+	syrup := createTestSyrup(t, "")
+
+	assert.Equal(t, "*Page[user.User]", syrup.getTypeName(types.NewPointer(inst), false))
+	assert.Equal(t, "[]Page[user.User]", syrup.getTypeName(types.NewSlice(inst), false))
+	assert.Equal(t, "map[string]*Page[user.User]", syrup.getTypeName(types.NewMap(types.Typ[types.String], types.NewPointer(inst)), false))
+}
+
+func TestSyrup_getTypeName_selfReference(t *testing.T) {
+	t.Parallel()
+
+	pkg := types.NewPackage("myapp", "myapp")
+
+	// type Node[T any] interface { Children() []Node[T] }
+	tparam := types.NewTypeParam(types.NewTypeName(token.NoPos, pkg, "T", nil), types.Universe.Lookup("any").Type())
+	node := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Node", nil), nil, nil)
+	node.SetTypeParams([]*types.TypeParam{tparam})
+
+	self, err := types.Instantiate(nil, node, []types.Type{tparam}, false)
+	require.NoError(t, err)
+
+	results := types.NewTuple(types.NewVar(token.NoPos, pkg, "", types.NewSlice(self)))
+	children := types.NewFunc(token.NoPos, pkg, "Children", types.NewSignatureType(nil, nil, nil, nil, results, false))
+	node.SetUnderlying(types.NewInterfaceType([]*types.Func{children}, nil).Complete())
+
+	inst, err := types.Instantiate(nil, node, []types.Type{types.Typ[types.Int]}, true)
+	require.NoError(t, err)
+
+	syrup := createTestSyrup(t, "")
+
+	assert.Equal(t, "[]Node[T]", syrup.getTypeName(children.Signature().Results().At(0).Type(), false))
+	assert.Equal(t, "Node[int]", syrup.getTypeName(inst, false))
+}
+
+func TestSyrup_getTypeName_chan(t *testing.T) {
+	t.Parallel()
+
+	eventPkg := types.NewPackage("example.com/event", "event")
+	event := types.NewNamed(types.NewTypeName(token.NoPos, eventPkg, "Event", nil), types.NewStruct(nil, nil), nil)
+
+	syrup := createTestSyrup(t, "")
+
+	assert.Equal(t, "<-chan event.Event", syrup.getTypeName(types.NewChan(types.RecvOnly, event), false))
+	assert.Equal(t, "chan<- *event.Event", syrup.getTypeName(types.NewChan(types.SendOnly, types.NewPointer(event)), false))
+	assert.Equal(t, "chan []event.Event", syrup.getTypeName(types.NewChan(types.SendRecv, types.NewSlice(event)), false))
+}
+
+func TestSyrup_getTypeName_pointerToArray(t *testing.T) {
+	t.Parallel()
+
+	recordPkg := types.NewPackage("example.com/record", "record")
+	entry := types.NewNamed(types.NewTypeName(token.NoPos, recordPkg, "Entry", nil), types.NewStruct(nil, nil), nil)
+
+	syrup := createTestSyrup(t, "")
+
+	assert.Equal(t, "*[32]byte", syrup.getTypeName(types.NewPointer(types.NewArray(types.Universe.Lookup("byte").Type(), 32)), false))
+	assert.Equal(t, "*[4]record.Entry", syrup.getTypeName(types.NewPointer(types.NewArray(entry, 4)), false))
+}
+
+func TestSyrup_getTypeName_mapOfFuncs(t *testing.T) {
+	t.Parallel()
+
+	contextPkg := types.NewPackage("context", "context")
+	ctx := types.NewNamed(types.NewTypeName(token.NoPos, contextPkg, "Context", nil), types.NewInterfaceType(nil, nil), nil)
+
+	handler := types.NewSignatureType(nil, nil, nil,
+		types.NewTuple(types.NewParam(token.NoPos, nil, "", ctx)),
+		types.NewTuple(types.NewParam(token.NoPos, nil, "", types.Universe.Lookup("error").Type())), false)
+
+	handlers := types.NewMap(types.Typ[types.String], handler)
+
+	syrup := createTestSyrup(t, "")
+
+	assert.Equal(t, "map[string]func(context.Context) (error)", syrup.getTypeName(handlers, false))
+	assert.Contains(t, getTypeImports(handlers, nil), "context")
+}
+
+func TestSyrup_getTypeName_interface(t *testing.T) {
+	t.Parallel()
+
+	ioPkg := types.NewPackage("io", "io")
+	modulePkg := types.NewPackage("golang.org/x/mod/module", "module")
+
+	closer := types.NewNamed(types.NewTypeName(token.NoPos, ioPkg, "Closer", nil), types.NewInterfaceType(nil, nil), nil)
+	version := types.NewNamed(types.NewTypeName(token.NoPos, modulePkg, "Version", nil), types.NewStruct(nil, nil), nil)
+
+	origin := types.NewFunc(token.NoPos, nil, "Origin", types.NewSignatureType(nil, nil, nil, nil,
+		types.NewTuple(types.NewParam(token.NoPos, nil, "", types.NewPointer(version))), false))
+
+	iface := types.NewInterfaceType([]*types.Func{origin}, []types.Type{closer}).Complete()
+
+	syrup := createTestSyrup(t, "")
+
+	assert.Equal(t, "interface{ io.Closer; Origin() (*module.Version) }", syrup.getTypeName(iface, false))
+	assert.Equal(t, "any", syrup.getTypeName(types.NewInterfaceType(nil, nil), false))
+	assert.Equal(t, "unsafe.Pointer", syrup.getTypeName(types.Typ[types.UnsafePointer], false))
+	assert.Equal(t, []string{"unsafe"}, getTypeImports(types.Typ[types.UnsafePointer], nil))
+	assert.Equal(t, "any", syrup.getTypeName(types.Universe.Lookup("any").Type(), false))
+	assert.Equal(t, "map[string]any", syrup.getTypeName(types.NewMap(types.Typ[types.String], types.NewInterfaceType(nil, nil)), false))
+	assert.ElementsMatch(t, []string{"", "io", "golang.org/x/mod/module"}, getTypeImports(iface, nil))
+
+	union := types.NewUnion([]*types.Term{
+		types.NewTerm(true, types.Typ[types.Int]),
+		types.NewTerm(false, version),
+	})
+
+	assert.Equal(t, "~int | module.Version", syrup.getTypeName(union, false))
+}
+
+func TestSyrup_getTypeName_interfacePointer(t *testing.T) {
+	t.Parallel()
+
+	pkg := types.NewPackage("example.com/hook", "hook")
+	modulePkg := types.NewPackage("golang.org/x/mod/module", "module")
+
+	version := types.NewNamed(types.NewTypeName(token.NoPos, modulePkg, "Version", nil), types.NewStruct(nil, nil), nil)
+
+	serve := types.NewFunc(token.NoPos, pkg, "Serve", types.NewSignatureType(nil, nil, nil,
+		types.NewTuple(types.NewParam(token.NoPos, pkg, "v", version)), nil, false))
+
+	handler := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Handler", nil), types.NewInterfaceType([]*types.Func{serve}, nil).Complete(), nil)
+	anonymous := types.NewInterfaceType([]*types.Func{serve}, nil).Complete()
+
+	syrup := createTestSyrup(t, "")
+
+	// The methods of a named interface don't contribute any import.
+	assert.Equal(t, "*hook.Handler", syrup.getTypeName(types.NewPointer(handler), false))
+	assert.Equal(t, []string{"example.com/hook"}, getTypeImports(types.NewPointer(handler), nil))
+
+	assert.Equal(t, "*interface{ Serve(module.Version) }", syrup.getTypeName(types.NewPointer(anonymous), false))
+	assert.Equal(t, []string{"", "golang.org/x/mod/module"}, getTypeImports(types.NewPointer(anonymous), nil))
+}
+
+func TestSyrup_getTypeName_alias(t *testing.T) {
+	t.Parallel()
+
+	fooPkg := types.NewPackage("example.com/foo", "foo")
+	barPkg := types.NewPackage("example.com/bar", "bar")
+
+	identifier := types.NewNamed(types.NewTypeName(token.NoPos, barPkg, "Identifier", nil), types.NewStruct(nil, nil), nil)
+
+	exported := types.NewAlias(types.NewTypeName(token.NoPos, fooPkg, "ID", nil), identifier)
+	unexported := types.NewAlias(types.NewTypeName(token.NoPos, fooPkg, "id", nil), identifier)
+
+	syrup := createTestSyrup(t, "")
+
+	assert.Equal(t, "foo.ID", syrup.getTypeName(exported, false))
+	assert.Equal(t, "[]foo.ID", syrup.getTypeName(types.NewSlice(exported), false))
+	assert.Equal(t, "bar.Identifier", syrup.getTypeName(unexported, false))
+	assert.Equal(t, "any", syrup.getTypeName(types.Universe.Lookup("any").Type(), false))
+
+	assert.Equal(t, []string{"example.com/foo"}, getTypeImports(exported, nil))
+	assert.Equal(t, []string{"example.com/bar"}, getTypeImports(unexported, nil))
+}
+
+func TestSyrup_getTypeName_replace(t *testing.T) {
+	t.Parallel()
+
+	internalPkg := types.NewPackage("example.com/lib/internal/b", "b")
+
+	skin := types.NewNamed(types.NewTypeName(token.NoPos, internalPkg, "Skin", nil), types.NewStruct(nil, nil), nil)
+	seed := types.NewNamed(types.NewTypeName(token.NoPos, internalPkg, "Seed", nil), types.NewStruct(nil, nil), nil)
+
+	replace := map[string]string{
+		"example.com/lib/internal/b.Skin": "example.com/lib/skin.Skin",
+		"example.com/lib/internal/b.Seed": "myapp.Seed",
+	}
+
+	syrup := createTestSyrup(t, "")
+	syrup.Replace = replace
+	syrup.Aliases = map[string]string{"example.com/lib/skin": "skin2"}
+
+	assert.Equal(t, "*skin2.Skin", syrup.getTypeName(types.NewPointer(skin), false))
+	assert.Equal(t, "[]Seed", syrup.getTypeName(types.NewSlice(seed), false))
+
+	assert.Equal(t, []string{"example.com/lib/skin"}, getTypeImports(types.NewPointer(skin), replace))
+	assert.Equal(t, []string{"example.com/lib/internal/b"}, getTypeImports(skin, nil))
+}
+
+func TestSyrup_createFuncSignature_variadicAny(t *testing.T) {
+	t.Parallel()
+
+	anyType := types.Universe.Lookup("any").Type()
+
+	params := types.NewTuple(
+		types.NewParam(0, nil, "format", types.Typ[types.String]),
+		types.NewParam(0, nil, "args", types.NewSlice(anyType)),
+	)
+	results := types.NewTuple(types.NewParam(0, nil, "", types.Typ[types.Int]))
+
+	syrup := Syrup{Signature: types.NewSignatureType(nil, nil, nil, params, results, true)}
+
+	assert.Equal(t, "func(string, ...any) ", syrup.createFuncSignature(params, nil))
+	assert.Equal(t, "func(string, ...any) (int)", syrup.createFuncSignature(params, results))
+
+	// A slice which is not variadic is kept.
+	syrup.Signature = types.NewSignatureType(nil, nil, nil, params, results, false)
+
+	assert.Equal(t, "func(string, []any) ", syrup.createFuncSignature(params, nil))
+}
+
+func TestSyrup_getParamNames(t *testing.T) {
+	t.Parallel()
+
+	stringType := types.Typ[types.String]
+
+	params := types.NewTuple(
+		types.NewParam(0, nil, "time", stringType),
+		types.NewParam(0, nil, "time_", stringType),
+		types.NewParam(0, nil, "mock", stringType),
+		types.NewParam(0, nil, "", stringType),
+	)
+
+	syrup := Syrup{ImportNames: []string{"mock", "testing", "time"}}
+
+	assert.Equal(t, []string{"time__", "time_", "mock_", "dParam"}, syrup.getParamNames(params))
+}
+
+func Test_getResultNames(t *testing.T) {
+	t.Parallel()
+
+	stringType := types.Typ[types.String]
+
+	results := types.NewTuple(
+		types.NewParam(0, nil, "left", stringType),
+		types.NewParam(0, nil, "_m", stringType),
+		types.NewParam(0, nil, "", stringType),
+		types.NewParam(0, nil, "_", stringType),
+		types.NewParam(0, nil, "_ra0", stringType),
+	)
+
+	names := getResultNames([]string{"_rc2"}, results, "_m", "_ret")
+
+	assert.Equal(t, []string{"left", "_rb1", "_rc2_", "_rd3", "_ra0"}, names)
+}
+
+func TestSyrup_WriteMockBase_bareConstructor(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+
+	var buffer bytes.Buffer
+	err := syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository"}, Options{BareConstructor: true})
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "// userRepositoryMock is a mock of the UserRepository interface (generated by mocktail).")
+	assert.Contains(t, output, "func newUserRepositoryMock(tb testing.TB) *userRepositoryMock {")
+	assert.Contains(t, output, "func newUserRepositoryMockBare() *userRepositoryMock {")
+}
+
+func TestSyrup_WriteMockBase_testifyStyle(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+
+	var buffer bytes.Buffer
+	err := syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository"}, Options{TestifyStyle: true})
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func newUserRepositoryMock(tb interface {\n\tmock.TestingT\n\tCleanup(func())\n}) *userRepositoryMock {")
+	assert.Contains(t, output, "m.Mock.Test(tb)")
+	assert.Contains(t, output, "tb.Cleanup(func() { m.AssertExpectations(tb) })")
+	assert.NotContains(t, output, "tb.Helper()")
+}
+
+func TestSyrup_WriteMockBase_stringer(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+
+	var buffer bytes.Buffer
+	err := syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository"}, Options{Stringer: true})
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "func (_m *userRepositoryMock) String() string {")
+	assert.Contains(t, output, "_callsMu sync.Mutex")
+	assert.Contains(t, output, "_m._callsMu.Lock()")
+	assert.Contains(t, output, "for _, c := range _m.ExpectedCalls {")
+	assert.Contains(t, output, "for _, c := range _m.Calls {")
+
+	// The interface already declares String: the mock implements it with the mocked method.
+	stringer := types.NewFunc(token.NoPos, nil, "String", types.NewSignatureType(nil, nil, nil, nil, nil, false))
+
+	buffer.Reset()
+	err = syrup.WriteMockBase(&buffer, InterfaceDesc{Name: "UserRepository", Methods: []*types.Func{stringer}}, Options{Stringer: true})
+	require.NoError(t, err)
+
+	assert.NotContains(t, buffer.String(), "String() string {")
+}
+
+func TestSyrup_WriteImports_banner(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+
+	pkgDesc := PackageDesc{
+		Pkg:     types.NewPackage("example.com/a", "a"),
+		Imports: map[string]struct{}{},
+	}
+
+	var buffer bytes.Buffer
+	err := syrup.WriteImports(&buffer, pkgDesc, Options{SPDX: "MIT", Banner: `Owned by the platform team.\n\nDo not use in production.`})
+	require.NoError(t, err)
+
+	expected := `// SPDX-License-Identifier: MIT
+// Code generated by mocktail; DO NOT EDIT.
+// mocktail:hash:`
+
+	assert.True(t, strings.HasPrefix(buffer.String(), expected))
+	assert.Contains(t, buffer.String(), "\n// Owned by the platform team.\n//\n// Do not use in production.\n\npackage a\n")
+}
+
+func Test_getBannerLines(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		banner   string
+		expected []string
+	}{
+		{banner: "", expected: nil},
+		{banner: "Owned by the platform team.", expected: []string{"// Owned by the platform team."}},
+		{banner: "first\nsecond\r\n", expected: []string{"// first", "// second"}},
+		{banner: `first\n\nthird`, expected: []string{"// first", "//", "// third"}},
+		{banner: "// already commented", expected: []string{"// // already commented"}},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.banner, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, getBannerLines(test.banner))
+		})
+	}
+}
+
+func TestSyrup_WriteMockBase_mustCall(t *testing.T) {
+	t.Parallel()
+
+	syrup := createTestSyrup(t, "")
+
+	interfaceDesc := InterfaceDesc{Name: "UserRepository", MustCall: []string{"Close", "Flush"}}
+
+	var buffer bytes.Buffer
+	err := syrup.WriteMockBase(&buffer, interfaceDesc, Options{Cleanup: true})
+	require.NoError(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, `tb.Cleanup(func() { m._assertMustCall(tb, "Close", "Flush") })`)
+	assert.Contains(t, output, "func (_m *userRepositoryMock) _assertMustCall(tb mock.TestingT, methods ...string) {")
+
+	// Without the option, the annotations are ignored.
+	buffer.Reset()
+	err = syrup.WriteMockBase(&buffer, interfaceDesc, Options{})
+	require.NoError(t, err)
+
+	assert.NotContains(t, buffer.String(), "_assertMustCall")
+}
+
+func Test_quickGoImports(t *testing.T) {
+	t.Parallel()
+
+	descPkg := PackageDesc{Imports: map[string]struct{}{
+		"golang.org/x/mod/module": {},
+		"a/b":                     {},
+		"bytes":                   {},
+		"example.com/foo.v1/bar":  {},
+		"github.com/foo/bar.v2":   {},
+		"net/http":                {},
+	}}
+
+	expected := []string{
+		"a/b",
+		"bytes",
+		"net/http",
+		"time",
+		"",
+		"example.com/foo.v1/bar",
+		"github.com/foo/bar.v2",
+		"github.com/stretchr/testify/mock",
+		"golang.org/x/mod/module",
+	}
+
+	assert.Equal(t, expected, quickGoImports(descPkg))
+}
+
+func Test_groupImports(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		imports  map[string]struct{}
+		expected []string
+	}{
+		{
+			desc:    "neither",
+			imports: map[string]struct{}{},
+		},
+		{
+			desc:     "only empty",
+			imports:  map[string]struct{}{"": {}},
+			expected: nil,
+		},
+		{
+			desc:     "only stdlib",
+			imports:  map[string]struct{}{"time": {}, "bytes": {}},
+			expected: []string{"bytes", "time"},
+		},
+		{
+			desc:     "only third-party",
+			imports:  map[string]struct{}{"golang.org/x/mod/module": {}, "github.com/foo/bar": {}},
+			expected: []string{"github.com/foo/bar", "golang.org/x/mod/module"},
+		},
+		{
+			desc:     "both",
+			imports:  map[string]struct{}{"golang.org/x/mod/module": {}, "time": {}, "": {}},
+			expected: []string{"time", "", "golang.org/x/mod/module"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, groupImports(test.imports))
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package d
+
+import "clash/strings"
+
+type Printer interface {
+	Print(b strings.Builder) error
+}
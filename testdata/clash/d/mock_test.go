@@ -0,0 +1,17 @@
+package d
+
+import (
+	"testing"
+
+	"clash/strings"
+)
+
+// mocktail:Printer
+
+func TestPrinter(t *testing.T) {
+	var p Printer = newPrinterMock(t).
+		OnPrint(strings.Builder{}).TypedReturns(nil).Once().
+		Parent
+
+	_ = p.Print(strings.Builder{})
+}
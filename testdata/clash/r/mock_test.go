@@ -0,0 +1,19 @@
+package r
+
+import (
+	"testing"
+
+	"clash/reflect"
+)
+
+// mocktail:Visitor
+
+func TestVisitor(t *testing.T) {
+	fn := func(v reflect.Value) error { return nil }
+
+	var v Visitor = newVisitorMock(t).
+		OnVisit(fn).TypedReturns(nil).Once().
+		Parent
+
+	_ = v.Visit(fn)
+}
@@ -0,0 +1,7 @@
+package r
+
+import "clash/reflect"
+
+type Visitor interface {
+	Visit(fn func(v reflect.Value) error) error
+}
@@ -0,0 +1,4 @@
+package reflect
+
+// Value is declared by a package named like a package of the standard library.
+type Value struct{}
@@ -0,0 +1,4 @@
+package strings
+
+// Builder is declared by a package named like a package of the standard library.
+type Builder struct{}
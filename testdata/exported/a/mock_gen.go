@@ -1,4 +1,5 @@
 // Code generated by mocktail; DO NOT EDIT.
+// mocktail:hash:e0fb67c4b704bf00
 
 package a
 
@@ -14,7 +15,7 @@ import (
 	"golang.org/x/mod/module"
 )
 
-// pineappleMock mock of Pineapple.
+// pineappleMock is a mock of the Pineapple interface (generated by mocktail).
 type pineappleMock struct{ mock.Mock }
 
 // NewPineappleMock creates a new pineappleMock.
@@ -45,6 +46,21 @@ func (_m *pineappleMock) OnCoo(bParam string, cParam Water) *pineappleCooCall {
 	return &pineappleCooCall{Call: _m.Mock.On("Coo", bParam, cParam), Parent: _m}
 }
 
+// OnCooMatched is like OnCoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *pineappleMock) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if bParam != nil {
+		_args[0] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[1] = mock.MatchedBy(cParam)
+	}
+
+	return &pineappleCooCall{Call: _m.Mock.On("Coo", _args...), Parent: _m}
+}
+
 func (_m *pineappleMock) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return &pineappleCooCall{Call: _m.Mock.On("Coo", bParam, cParam), Parent: _m}
 }
@@ -133,6 +149,14 @@ func (_c *pineappleCooCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleCooCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleCooCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleCooCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -220,8 +244,8 @@ func (_c *pineappleGooCall) Maybe() *pineappleGooCall {
 	return _c
 }
 
-func (_c *pineappleGooCall) TypedReturns(a string, b int, c Water) *pineappleGooCall {
-	_c.Call = _c.Return(a, b, c)
+func (_c *pineappleGooCall) TypedReturns(a string, d int, e Water) *pineappleGooCall {
+	_c.Call = _c.Return(a, d, e)
 	return _c
 }
 
@@ -257,6 +281,14 @@ func (_c *pineappleGooCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleGooCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleGooCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleGooCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -293,6 +325,17 @@ func (_m *pineappleMock) OnHello(bar Water) *pineappleHelloCall {
 	return &pineappleHelloCall{Call: _m.Mock.On("Hello", bar), Parent: _m}
 }
 
+// OnHelloMatched is like OnHello but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *pineappleMock) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	_args := []interface{}{mock.Anything}
+
+	if bar != nil {
+		_args[0] = mock.MatchedBy(bar)
+	}
+
+	return &pineappleHelloCall{Call: _m.Mock.On("Hello", _args...), Parent: _m}
+}
+
 func (_m *pineappleMock) OnHelloRaw(bar interface{}) *pineappleHelloCall {
 	return &pineappleHelloCall{Call: _m.Mock.On("Hello", bar), Parent: _m}
 }
@@ -380,6 +423,14 @@ func (_c *pineappleHelloCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleHelloCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleHelloCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleHelloCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -502,6 +553,14 @@ func (_c *pineappleNooCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleNooCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleNooCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleNooCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -624,6 +683,14 @@ func (_c *pineappleWorldCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleWorldCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleWorldCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleWorldCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -644,7 +711,7 @@ func (_c *pineappleWorldCall) OnWorldRaw() *pineappleWorldCall {
 	return _c.Parent.OnWorldRaw()
 }
 
-// coconutMock mock of Coconut.
+// coconutMock is a mock of the Coconut interface (generated by mocktail).
 type coconutMock struct{ mock.Mock }
 
 // NewCoconutMock creates a new coconutMock.
@@ -675,6 +742,17 @@ func (_m *coconutMock) OnBoo(src *bytes.Buffer) *coconutBooCall {
 	return &coconutBooCall{Call: _m.Mock.On("Boo", src), Parent: _m}
 }
 
+// OnBooMatched is like OnBoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutBooCall{Call: _m.Mock.On("Boo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnBooRaw(src interface{}) *coconutBooCall {
 	return &coconutBooCall{Call: _m.Mock.On("Boo", src), Parent: _m}
 }
@@ -790,10 +868,62 @@ func (_c *coconutBooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutBooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutBooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutBooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutBooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutBooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutBooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutBooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutBooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutBooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutBooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutBooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutBooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutBooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutBooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutBooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutBooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -862,6 +992,17 @@ func (_m *coconutMock) OnDoo(src time.Duration) *coconutDooCall {
 	return &coconutDooCall{Call: _m.Mock.On("Doo", src), Parent: _m}
 }
 
+// OnDooMatched is like OnDoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutDooCall{Call: _m.Mock.On("Doo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnDooRaw(src interface{}) *coconutDooCall {
 	return &coconutDooCall{Call: _m.Mock.On("Doo", src), Parent: _m}
 }
@@ -977,10 +1118,62 @@ func (_c *coconutDooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutDooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutDooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutDooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutDooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutDooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutDooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutDooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutDooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutDooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutDooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutDooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutDooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutDooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutDooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutDooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutDooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1049,6 +1242,17 @@ func (_m *coconutMock) OnFoo(st Strawberry) *coconutFooCall {
 	return &coconutFooCall{Call: _m.Mock.On("Foo", st), Parent: _m}
 }
 
+// OnFooMatched is like OnFoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutFooCall{Call: _m.Mock.On("Foo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnFooRaw(st interface{}) *coconutFooCall {
 	return &coconutFooCall{Call: _m.Mock.On("Foo", st), Parent: _m}
 }
@@ -1164,10 +1368,62 @@ func (_c *coconutFooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutFooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutFooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutFooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutFooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutFooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutFooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutFooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutFooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutFooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutFooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutFooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutFooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutFooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutFooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutFooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutFooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1236,6 +1492,17 @@ func (_m *coconutMock) OnGoo(st string) *coconutGooCall {
 	return &coconutGooCall{Call: _m.Mock.On("Goo", st), Parent: _m}
 }
 
+// OnGooMatched is like OnGoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnGooMatched(st func(string) bool) *coconutGooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutGooCall{Call: _m.Mock.On("Goo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnGooRaw(st interface{}) *coconutGooCall {
 	return &coconutGooCall{Call: _m.Mock.On("Goo", st), Parent: _m}
 }
@@ -1351,10 +1618,62 @@ func (_c *coconutGooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutGooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutGooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutGooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutGooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutGooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutGooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutGooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutGooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutGooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutGooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutGooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutGooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutGooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutGooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutGooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutGooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1415,6 +1734,25 @@ func (_m *coconutMock) OnHoo(aParam string, bParam int, cParam Water) *coconutHo
 	return &coconutHooCall{Call: _m.Mock.On("Hoo", aParam, bParam, cParam), Parent: _m}
 }
 
+// OnHooMatched is like OnHoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	_args := []interface{}{mock.Anything, mock.Anything, mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	if bParam != nil {
+		_args[1] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[2] = mock.MatchedBy(cParam)
+	}
+
+	return &coconutHooCall{Call: _m.Mock.On("Hoo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
 	return &coconutHooCall{Call: _m.Mock.On("Hoo", aParam, bParam, cParam), Parent: _m}
 }
@@ -1522,10 +1860,62 @@ func (_c *coconutHooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutHooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutHooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutHooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutHooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutHooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutHooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutHooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutHooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutHooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutHooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutHooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutHooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutHooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutHooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutHooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutHooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1595,6 +1985,25 @@ func (_m *coconutMock) OnJoo(aParam string, bParam int, cParam Water) *coconutJo
 	return &coconutJooCall{Call: _m.Mock.On("Joo", aParam, bParam, cParam), Parent: _m}
 }
 
+// OnJooMatched is like OnJoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	_args := []interface{}{mock.Anything, mock.Anything, mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	if bParam != nil {
+		_args[1] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[2] = mock.MatchedBy(cParam)
+	}
+
+	return &coconutJooCall{Call: _m.Mock.On("Joo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
 	return &coconutJooCall{Call: _m.Mock.On("Joo", aParam, bParam, cParam), Parent: _m}
 }
@@ -1644,8 +2053,8 @@ func (_c *coconutJooCall) Maybe() *coconutJooCall {
 	return _c
 }
 
-func (_c *coconutJooCall) TypedReturns(a string, b int) *coconutJooCall {
-	_c.Call = _c.Return(a, b)
+func (_c *coconutJooCall) TypedReturns(a string, d int) *coconutJooCall {
+	_c.Call = _c.Return(a, d)
 	return _c
 }
 
@@ -1712,10 +2121,62 @@ func (_c *coconutJooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutJooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutJooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutJooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutJooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutJooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutJooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutJooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutJooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutJooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutJooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutJooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutJooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutJooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutJooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutJooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutJooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1784,6 +2245,17 @@ func (_m *coconutMock) OnKoo(src string) *coconutKooCall {
 	return &coconutKooCall{Call: _m.Mock.On("Koo", src), Parent: _m}
 }
 
+// OnKooMatched is like OnKoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnKooMatched(src func(string) bool) *coconutKooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutKooCall{Call: _m.Mock.On("Koo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnKooRaw(src interface{}) *coconutKooCall {
 	return &coconutKooCall{Call: _m.Mock.On("Koo", src), Parent: _m}
 }
@@ -1899,10 +2371,62 @@ func (_c *coconutKooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutKooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutKooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutKooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutKooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutKooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutKooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutKooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutKooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutKooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutKooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutKooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutKooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutKooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutKooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutKooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutKooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1971,6 +2495,21 @@ func (_m *coconutMock) OnLoo(st string, values ...int) *coconutLooCall {
 	return &coconutLooCall{Call: _m.Mock.On("Loo", st, values), Parent: _m}
 }
 
+// OnLooMatched is like OnLoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	if values != nil {
+		_args[1] = mock.MatchedBy(values)
+	}
+
+	return &coconutLooCall{Call: _m.Mock.On("Loo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
 	return &coconutLooCall{Call: _m.Mock.On("Loo", st, values), Parent: _m}
 }
@@ -2087,10 +2626,62 @@ func (_c *coconutLooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutLooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutLooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutLooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutLooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutLooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutLooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutLooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutLooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutLooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutLooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutLooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutLooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutLooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutLooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutLooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutLooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2159,6 +2750,17 @@ func (_m *coconutMock) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconut
 	return &coconutMooCall{Call: _m.Mock.On("Moo", mock.Anything), Parent: _m}
 }
 
+// OnMooMatched is like OnMoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	_args := []interface{}{mock.Anything}
+
+	if fn != nil {
+		_args[0] = mock.MatchedBy(fn)
+	}
+
+	return &coconutMooCall{Call: _m.Mock.On("Moo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnMooRaw(fn interface{}) *coconutMooCall {
 	return &coconutMooCall{Call: _m.Mock.On("Moo", mock.Anything), Parent: _m}
 }
@@ -2274,10 +2876,62 @@ func (_c *coconutMooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutMooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutMooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutMooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutMooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutMooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutMooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutMooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutMooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutMooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutMooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutMooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutMooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutMooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutMooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutMooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutMooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2346,6 +3000,17 @@ func (_m *coconutMock) OnToo(src string) *coconutTooCall {
 	return &coconutTooCall{Call: _m.Mock.On("Too", src), Parent: _m}
 }
 
+// OnTooMatched is like OnToo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnTooMatched(src func(string) bool) *coconutTooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutTooCall{Call: _m.Mock.On("Too", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnTooRaw(src interface{}) *coconutTooCall {
 	return &coconutTooCall{Call: _m.Mock.On("Too", src), Parent: _m}
 }
@@ -2461,10 +3126,62 @@ func (_c *coconutTooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutTooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutTooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutTooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutTooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutTooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutTooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutTooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutTooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutTooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutTooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutTooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutTooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutTooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutTooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2533,6 +3250,17 @@ func (_m *coconutMock) OnVoo(src *module.Version) *coconutVooCall {
 	return &coconutVooCall{Call: _m.Mock.On("Voo", src), Parent: _m}
 }
 
+// OnVooMatched is like OnVoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutVooCall{Call: _m.Mock.On("Voo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnVooRaw(src interface{}) *coconutVooCall {
 	return &coconutVooCall{Call: _m.Mock.On("Voo", src), Parent: _m}
 }
@@ -2648,10 +3376,62 @@ func (_c *coconutVooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutVooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutVooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutVooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutVooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutVooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutVooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutVooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutVooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutVooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutVooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutVooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutVooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutVooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutVooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2704,14 +3484,14 @@ func (_c *coconutVooCall) OnZooRaw(st interface{}) *coconutZooCall {
 	return _c.Parent.OnZooRaw(st)
 }
 
-func (_m *coconutMock) Yoo(st string) interface{} {
+func (_m *coconutMock) Yoo(st string) any {
 	_ret := _m.Called(st)
 
-	if _rf, ok := _ret.Get(0).(func(string) interface{}); ok {
+	if _rf, ok := _ret.Get(0).(func(string) any); ok {
 		return _rf(st)
 	}
 
-	_ra0, _ := _ret.Get(0).(interface{})
+	_ra0, _ := _ret.Get(0).(any)
 
 	return _ra0
 }
@@ -2720,6 +3500,17 @@ func (_m *coconutMock) OnYoo(st string) *coconutYooCall {
 	return &coconutYooCall{Call: _m.Mock.On("Yoo", st), Parent: _m}
 }
 
+// OnYooMatched is like OnYoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnYooMatched(st func(string) bool) *coconutYooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutYooCall{Call: _m.Mock.On("Yoo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnYooRaw(st interface{}) *coconutYooCall {
 	return &coconutYooCall{Call: _m.Mock.On("Yoo", st), Parent: _m}
 }
@@ -2769,12 +3560,12 @@ func (_c *coconutYooCall) Maybe() *coconutYooCall {
 	return _c
 }
 
-func (_c *coconutYooCall) TypedReturns(a interface{}) *coconutYooCall {
+func (_c *coconutYooCall) TypedReturns(a any) *coconutYooCall {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *coconutYooCall) ReturnsFn(fn func(string) interface{}) *coconutYooCall {
+func (_c *coconutYooCall) ReturnsFn(fn func(string) any) *coconutYooCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
@@ -2835,10 +3626,62 @@ func (_c *coconutYooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutYooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutYooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutYooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutYooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutYooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutYooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutYooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutYooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutYooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutYooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutYooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutYooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutYooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutYooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2891,10 +3734,10 @@ func (_c *coconutYooCall) OnZooRaw(st interface{}) *coconutZooCall {
 	return _c.Parent.OnZooRaw(st)
 }
 
-func (_m *coconutMock) Zoo(st interface{}) string {
+func (_m *coconutMock) Zoo(st any) string {
 	_ret := _m.Called(st)
 
-	if _rf, ok := _ret.Get(0).(func(interface{}) string); ok {
+	if _rf, ok := _ret.Get(0).(func(any) string); ok {
 		return _rf(st)
 	}
 
@@ -2903,10 +3746,21 @@ func (_m *coconutMock) Zoo(st interface{}) string {
 	return _ra0
 }
 
-func (_m *coconutMock) OnZoo(st interface{}) *coconutZooCall {
+func (_m *coconutMock) OnZoo(st any) *coconutZooCall {
 	return &coconutZooCall{Call: _m.Mock.On("Zoo", st), Parent: _m}
 }
 
+// OnZooMatched is like OnZoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnZooMatched(st func(any) bool) *coconutZooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutZooCall{Call: _m.Mock.On("Zoo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnZooRaw(st interface{}) *coconutZooCall {
 	return &coconutZooCall{Call: _m.Mock.On("Zoo", st), Parent: _m}
 }
@@ -2961,14 +3815,14 @@ func (_c *coconutZooCall) TypedReturns(a string) *coconutZooCall {
 	return _c
 }
 
-func (_c *coconutZooCall) ReturnsFn(fn func(interface{}) string) *coconutZooCall {
+func (_c *coconutZooCall) ReturnsFn(fn func(any) string) *coconutZooCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *coconutZooCall) TypedRun(fn func(interface{})) *coconutZooCall {
+func (_c *coconutZooCall) TypedRun(fn func(any)) *coconutZooCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_st, _ := args.Get(0).(interface{})
+		_st, _ := args.Get(0).(any)
 		fn(_st)
 	})
 	return _c
@@ -3022,10 +3876,62 @@ func (_c *coconutZooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutZooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutZooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutZooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutZooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutZooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutZooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutZooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutZooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutZooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutZooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutZooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutZooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutZooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutZooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -3078,7 +3984,7 @@ func (_c *coconutZooCall) OnZooRaw(st interface{}) *coconutZooCall {
 	return _c.Parent.OnZooRaw(st)
 }
 
-// carrotMock mock of Carrot.
+// carrotMock is a mock of the Carrot interface (generated by mocktail).
 type carrotMock struct{ mock.Mock }
 
 // NewCarrotMock creates a new carrotMock.
@@ -3109,6 +4015,17 @@ func (_m *carrotMock) OnBar(aParam string) *carrotBarCall {
 	return &carrotBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
 }
 
+// OnBarMatched is like OnBar but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *carrotMock) OnBarMatched(aParam func(string) bool) *carrotBarCall {
+	_args := []interface{}{mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	return &carrotBarCall{Call: _m.Mock.On("Bar", _args...), Parent: _m}
+}
+
 func (_m *carrotMock) OnBarRaw(aParam interface{}) *carrotBarCall {
 	return &carrotBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
 }
@@ -3184,6 +4101,14 @@ func (_c *carrotBarCall) OnBur(aParam string) *carrotBurCall {
 	return _c.Parent.OnBur(aParam)
 }
 
+func (_c *carrotBarCall) OnBarMatched(aParam func(string) bool) *carrotBarCall {
+	return _c.Parent.OnBarMatched(aParam)
+}
+
+func (_c *carrotBarCall) OnBurMatched(aParam func(string) bool) *carrotBurCall {
+	return _c.Parent.OnBurMatched(aParam)
+}
+
 func (_c *carrotBarCall) OnBarRaw(aParam interface{}) *carrotBarCall {
 	return _c.Parent.OnBarRaw(aParam)
 }
@@ -3208,6 +4133,17 @@ func (_m *carrotMock) OnBur(aParam string) *carrotBurCall {
 	return &carrotBurCall{Call: _m.Mock.On("Bur", aParam), Parent: _m}
 }
 
+// OnBurMatched is like OnBur but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *carrotMock) OnBurMatched(aParam func(string) bool) *carrotBurCall {
+	_args := []interface{}{mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	return &carrotBurCall{Call: _m.Mock.On("Bur", _args...), Parent: _m}
+}
+
 func (_m *carrotMock) OnBurRaw(aParam interface{}) *carrotBurCall {
 	return &carrotBurCall{Call: _m.Mock.On("Bur", aParam), Parent: _m}
 }
@@ -3283,6 +4219,14 @@ func (_c *carrotBurCall) OnBur(aParam string) *carrotBurCall {
 	return _c.Parent.OnBur(aParam)
 }
 
+func (_c *carrotBurCall) OnBarMatched(aParam func(string) bool) *carrotBarCall {
+	return _c.Parent.OnBarMatched(aParam)
+}
+
+func (_c *carrotBurCall) OnBurMatched(aParam func(string) bool) *carrotBurCall {
+	return _c.Parent.OnBurMatched(aParam)
+}
+
 func (_c *carrotBurCall) OnBarRaw(aParam interface{}) *carrotBarCall {
 	return _c.Parent.OnBarRaw(aParam)
 }
@@ -3291,7 +4235,7 @@ func (_c *carrotBurCall) OnBurRaw(aParam interface{}) *carrotBurCall {
 	return _c.Parent.OnBurRaw(aParam)
 }
 
-// orangeMock mock of Orange.
+// orangeMock is a mock of the Orange interface (generated by mocktail).
 type orangeMock struct{ mock.Mock }
 
 // NewOrangeMock creates a new orangeMock.
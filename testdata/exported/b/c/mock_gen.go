@@ -1,4 +1,5 @@
 // Code generated by mocktail; DO NOT EDIT.
+// mocktail:hash:6c31b35affbdaa55
 
 package c
 
@@ -12,7 +13,7 @@ import (
 	"golang.org/x/mod/module"
 )
 
-// pineappleMock mock of Pineapple.
+// pineappleMock is a mock of the Pineapple interface (generated by mocktail).
 type pineappleMock struct{ mock.Mock }
 
 // NewPineappleMock creates a new pineappleMock.
@@ -43,6 +44,21 @@ func (_m *pineappleMock) OnCoo(bParam string, cParam Water) *pineappleCooCall {
 	return &pineappleCooCall{Call: _m.Mock.On("Coo", bParam, cParam), Parent: _m}
 }
 
+// OnCooMatched is like OnCoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *pineappleMock) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if bParam != nil {
+		_args[0] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[1] = mock.MatchedBy(cParam)
+	}
+
+	return &pineappleCooCall{Call: _m.Mock.On("Coo", _args...), Parent: _m}
+}
+
 func (_m *pineappleMock) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return &pineappleCooCall{Call: _m.Mock.On("Coo", bParam, cParam), Parent: _m}
 }
@@ -127,6 +143,14 @@ func (_c *pineappleCooCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleCooCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleCooCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleCooCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -243,6 +267,14 @@ func (_c *pineappleGooCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleGooCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleGooCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleGooCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -275,6 +307,17 @@ func (_m *pineappleMock) OnHello(bar Water) *pineappleHelloCall {
 	return &pineappleHelloCall{Call: _m.Mock.On("Hello", bar), Parent: _m}
 }
 
+// OnHelloMatched is like OnHello but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *pineappleMock) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	_args := []interface{}{mock.Anything}
+
+	if bar != nil {
+		_args[0] = mock.MatchedBy(bar)
+	}
+
+	return &pineappleHelloCall{Call: _m.Mock.On("Hello", _args...), Parent: _m}
+}
+
 func (_m *pineappleMock) OnHelloRaw(bar interface{}) *pineappleHelloCall {
 	return &pineappleHelloCall{Call: _m.Mock.On("Hello", bar), Parent: _m}
 }
@@ -358,6 +401,14 @@ func (_c *pineappleHelloCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleHelloCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleHelloCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleHelloCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -472,6 +523,14 @@ func (_c *pineappleWorldCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleWorldCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleWorldCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleWorldCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -488,7 +547,7 @@ func (_c *pineappleWorldCall) OnWorldRaw() *pineappleWorldCall {
 	return _c.Parent.OnWorldRaw()
 }
 
-// coconutMock mock of Coconut.
+// coconutMock is a mock of the Coconut interface (generated by mocktail).
 type coconutMock struct{ mock.Mock }
 
 // NewCoconutMock creates a new coconutMock.
@@ -519,6 +578,17 @@ func (_m *coconutMock) OnBoo(src *bytes.Buffer) *coconutBooCall {
 	return &coconutBooCall{Call: _m.Mock.On("Boo", src), Parent: _m}
 }
 
+// OnBooMatched is like OnBoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutBooCall{Call: _m.Mock.On("Boo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnBooRaw(src interface{}) *coconutBooCall {
 	return &coconutBooCall{Call: _m.Mock.On("Boo", src), Parent: _m}
 }
@@ -634,10 +704,62 @@ func (_c *coconutBooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutBooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutBooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutBooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutBooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutBooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutBooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutBooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutBooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutBooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutBooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutBooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutBooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutBooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutBooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutBooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutBooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -706,6 +828,17 @@ func (_m *coconutMock) OnDoo(src time.Duration) *coconutDooCall {
 	return &coconutDooCall{Call: _m.Mock.On("Doo", src), Parent: _m}
 }
 
+// OnDooMatched is like OnDoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutDooCall{Call: _m.Mock.On("Doo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnDooRaw(src interface{}) *coconutDooCall {
 	return &coconutDooCall{Call: _m.Mock.On("Doo", src), Parent: _m}
 }
@@ -821,10 +954,62 @@ func (_c *coconutDooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutDooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutDooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutDooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutDooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutDooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutDooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutDooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutDooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutDooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutDooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutDooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutDooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutDooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutDooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutDooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutDooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -893,6 +1078,17 @@ func (_m *coconutMock) OnFoo(st Strawberry) *coconutFooCall {
 	return &coconutFooCall{Call: _m.Mock.On("Foo", st), Parent: _m}
 }
 
+// OnFooMatched is like OnFoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutFooCall{Call: _m.Mock.On("Foo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnFooRaw(st interface{}) *coconutFooCall {
 	return &coconutFooCall{Call: _m.Mock.On("Foo", st), Parent: _m}
 }
@@ -1008,10 +1204,62 @@ func (_c *coconutFooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutFooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutFooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutFooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutFooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutFooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutFooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutFooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutFooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutFooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutFooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutFooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutFooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutFooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutFooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutFooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutFooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1080,6 +1328,17 @@ func (_m *coconutMock) OnGoo(st string) *coconutGooCall {
 	return &coconutGooCall{Call: _m.Mock.On("Goo", st), Parent: _m}
 }
 
+// OnGooMatched is like OnGoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnGooMatched(st func(string) bool) *coconutGooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutGooCall{Call: _m.Mock.On("Goo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnGooRaw(st interface{}) *coconutGooCall {
 	return &coconutGooCall{Call: _m.Mock.On("Goo", st), Parent: _m}
 }
@@ -1195,10 +1454,62 @@ func (_c *coconutGooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutGooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutGooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutGooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutGooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutGooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutGooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutGooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutGooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutGooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutGooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutGooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutGooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutGooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutGooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutGooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutGooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1259,6 +1570,25 @@ func (_m *coconutMock) OnHoo(aParam string, bParam int, cParam Water) *coconutHo
 	return &coconutHooCall{Call: _m.Mock.On("Hoo", aParam, bParam, cParam), Parent: _m}
 }
 
+// OnHooMatched is like OnHoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	_args := []interface{}{mock.Anything, mock.Anything, mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	if bParam != nil {
+		_args[1] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[2] = mock.MatchedBy(cParam)
+	}
+
+	return &coconutHooCall{Call: _m.Mock.On("Hoo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
 	return &coconutHooCall{Call: _m.Mock.On("Hoo", aParam, bParam, cParam), Parent: _m}
 }
@@ -1366,10 +1696,62 @@ func (_c *coconutHooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutHooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutHooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutHooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutHooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutHooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutHooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutHooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutHooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutHooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutHooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutHooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutHooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutHooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutHooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutHooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutHooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1439,6 +1821,25 @@ func (_m *coconutMock) OnJoo(aParam string, bParam int, cParam Water) *coconutJo
 	return &coconutJooCall{Call: _m.Mock.On("Joo", aParam, bParam, cParam), Parent: _m}
 }
 
+// OnJooMatched is like OnJoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	_args := []interface{}{mock.Anything, mock.Anything, mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	if bParam != nil {
+		_args[1] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[2] = mock.MatchedBy(cParam)
+	}
+
+	return &coconutJooCall{Call: _m.Mock.On("Joo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
 	return &coconutJooCall{Call: _m.Mock.On("Joo", aParam, bParam, cParam), Parent: _m}
 }
@@ -1556,10 +1957,62 @@ func (_c *coconutJooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutJooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutJooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutJooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutJooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutJooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutJooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutJooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutJooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutJooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutJooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutJooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutJooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutJooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutJooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutJooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutJooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1628,6 +2081,17 @@ func (_m *coconutMock) OnKoo(src string) *coconutKooCall {
 	return &coconutKooCall{Call: _m.Mock.On("Koo", src), Parent: _m}
 }
 
+// OnKooMatched is like OnKoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnKooMatched(src func(string) bool) *coconutKooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutKooCall{Call: _m.Mock.On("Koo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnKooRaw(src interface{}) *coconutKooCall {
 	return &coconutKooCall{Call: _m.Mock.On("Koo", src), Parent: _m}
 }
@@ -1743,10 +2207,62 @@ func (_c *coconutKooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutKooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutKooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutKooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutKooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutKooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutKooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutKooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutKooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutKooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutKooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutKooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutKooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutKooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutKooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutKooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutKooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1815,6 +2331,21 @@ func (_m *coconutMock) OnLoo(st string, values ...int) *coconutLooCall {
 	return &coconutLooCall{Call: _m.Mock.On("Loo", st, values), Parent: _m}
 }
 
+// OnLooMatched is like OnLoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	if values != nil {
+		_args[1] = mock.MatchedBy(values)
+	}
+
+	return &coconutLooCall{Call: _m.Mock.On("Loo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
 	return &coconutLooCall{Call: _m.Mock.On("Loo", st, values), Parent: _m}
 }
@@ -1931,10 +2462,62 @@ func (_c *coconutLooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutLooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutLooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutLooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutLooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutLooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutLooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutLooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutLooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutLooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutLooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutLooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutLooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutLooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutLooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutLooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutLooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2003,6 +2586,17 @@ func (_m *coconutMock) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconut
 	return &coconutMooCall{Call: _m.Mock.On("Moo", mock.Anything), Parent: _m}
 }
 
+// OnMooMatched is like OnMoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	_args := []interface{}{mock.Anything}
+
+	if fn != nil {
+		_args[0] = mock.MatchedBy(fn)
+	}
+
+	return &coconutMooCall{Call: _m.Mock.On("Moo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnMooRaw(fn interface{}) *coconutMooCall {
 	return &coconutMooCall{Call: _m.Mock.On("Moo", mock.Anything), Parent: _m}
 }
@@ -2118,10 +2712,62 @@ func (_c *coconutMooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutMooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutMooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutMooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutMooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutMooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutMooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutMooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutMooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutMooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutMooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutMooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutMooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutMooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutMooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutMooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutMooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2190,6 +2836,17 @@ func (_m *coconutMock) OnToo(src string) *coconutTooCall {
 	return &coconutTooCall{Call: _m.Mock.On("Too", src), Parent: _m}
 }
 
+// OnTooMatched is like OnToo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnTooMatched(src func(string) bool) *coconutTooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutTooCall{Call: _m.Mock.On("Too", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnTooRaw(src interface{}) *coconutTooCall {
 	return &coconutTooCall{Call: _m.Mock.On("Too", src), Parent: _m}
 }
@@ -2305,10 +2962,62 @@ func (_c *coconutTooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutTooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutTooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutTooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutTooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutTooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutTooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutTooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutTooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutTooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutTooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutTooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutTooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutTooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutTooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2377,6 +3086,17 @@ func (_m *coconutMock) OnVoo(src *module.Version) *coconutVooCall {
 	return &coconutVooCall{Call: _m.Mock.On("Voo", src), Parent: _m}
 }
 
+// OnVooMatched is like OnVoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutVooCall{Call: _m.Mock.On("Voo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnVooRaw(src interface{}) *coconutVooCall {
 	return &coconutVooCall{Call: _m.Mock.On("Voo", src), Parent: _m}
 }
@@ -2492,10 +3212,62 @@ func (_c *coconutVooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutVooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutVooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutVooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutVooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutVooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutVooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutVooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutVooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutVooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutVooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutVooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutVooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutVooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutVooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2548,14 +3320,14 @@ func (_c *coconutVooCall) OnZooRaw(st interface{}) *coconutZooCall {
 	return _c.Parent.OnZooRaw(st)
 }
 
-func (_m *coconutMock) Yoo(st string) interface{} {
+func (_m *coconutMock) Yoo(st string) any {
 	_ret := _m.Called(st)
 
-	if _rf, ok := _ret.Get(0).(func(string) interface{}); ok {
+	if _rf, ok := _ret.Get(0).(func(string) any); ok {
 		return _rf(st)
 	}
 
-	_ra0, _ := _ret.Get(0).(interface{})
+	_ra0, _ := _ret.Get(0).(any)
 
 	return _ra0
 }
@@ -2564,6 +3336,17 @@ func (_m *coconutMock) OnYoo(st string) *coconutYooCall {
 	return &coconutYooCall{Call: _m.Mock.On("Yoo", st), Parent: _m}
 }
 
+// OnYooMatched is like OnYoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnYooMatched(st func(string) bool) *coconutYooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutYooCall{Call: _m.Mock.On("Yoo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnYooRaw(st interface{}) *coconutYooCall {
 	return &coconutYooCall{Call: _m.Mock.On("Yoo", st), Parent: _m}
 }
@@ -2613,12 +3396,12 @@ func (_c *coconutYooCall) Maybe() *coconutYooCall {
 	return _c
 }
 
-func (_c *coconutYooCall) TypedReturns(a interface{}) *coconutYooCall {
+func (_c *coconutYooCall) TypedReturns(a any) *coconutYooCall {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *coconutYooCall) ReturnsFn(fn func(string) interface{}) *coconutYooCall {
+func (_c *coconutYooCall) ReturnsFn(fn func(string) any) *coconutYooCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
@@ -2679,10 +3462,62 @@ func (_c *coconutYooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutYooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutYooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutYooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutYooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutYooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutYooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutYooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutYooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutYooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutYooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutYooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutYooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutYooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutYooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2735,10 +3570,10 @@ func (_c *coconutYooCall) OnZooRaw(st interface{}) *coconutZooCall {
 	return _c.Parent.OnZooRaw(st)
 }
 
-func (_m *coconutMock) Zoo(st interface{}) string {
+func (_m *coconutMock) Zoo(st any) string {
 	_ret := _m.Called(st)
 
-	if _rf, ok := _ret.Get(0).(func(interface{}) string); ok {
+	if _rf, ok := _ret.Get(0).(func(any) string); ok {
 		return _rf(st)
 	}
 
@@ -2747,10 +3582,21 @@ func (_m *coconutMock) Zoo(st interface{}) string {
 	return _ra0
 }
 
-func (_m *coconutMock) OnZoo(st interface{}) *coconutZooCall {
+func (_m *coconutMock) OnZoo(st any) *coconutZooCall {
 	return &coconutZooCall{Call: _m.Mock.On("Zoo", st), Parent: _m}
 }
 
+// OnZooMatched is like OnZoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnZooMatched(st func(any) bool) *coconutZooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutZooCall{Call: _m.Mock.On("Zoo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnZooRaw(st interface{}) *coconutZooCall {
 	return &coconutZooCall{Call: _m.Mock.On("Zoo", st), Parent: _m}
 }
@@ -2805,14 +3651,14 @@ func (_c *coconutZooCall) TypedReturns(a string) *coconutZooCall {
 	return _c
 }
 
-func (_c *coconutZooCall) ReturnsFn(fn func(interface{}) string) *coconutZooCall {
+func (_c *coconutZooCall) ReturnsFn(fn func(any) string) *coconutZooCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *coconutZooCall) TypedRun(fn func(interface{})) *coconutZooCall {
+func (_c *coconutZooCall) TypedRun(fn func(any)) *coconutZooCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_st, _ := args.Get(0).(interface{})
+		_st, _ := args.Get(0).(any)
 		fn(_st)
 	})
 	return _c
@@ -2866,10 +3712,62 @@ func (_c *coconutZooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutZooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutZooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutZooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutZooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutZooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutZooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutZooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutZooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutZooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutZooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutZooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutZooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutZooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutZooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
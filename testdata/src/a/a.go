@@ -3,8 +3,16 @@ package a
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"io"
 	"time"
+	"unsafe"
 
+	"a/b"
+	fsql "a/f/sql"
+	"a/g"
+	"a/j"
+	"a/record"
 	"golang.org/x/mod/module"
 )
 
@@ -14,6 +22,7 @@ type Pineapple interface {
 	Goo() (string, int, Water)
 	Coo(context.Context, string, Water) Water
 	Noo(context.Context) string
+	Ping(ctx context.Context) error
 }
 
 type Coconut interface {
@@ -32,6 +41,13 @@ type Coconut interface {
 	Moo(fn func(st, stban Strawberry) Pineapple) string
 	Noo(ar [][2]string) string
 	Poo(str struct{ name string }) string
+	Qoo(a string, c int) (string, int, bool, error, Water, []byte)
+	Roo(errs []error) []error
+	Soo(errs map[string]error) (map[string]error, error)
+	Split(src string) (left, right string)
+	Pair() (string, string)
+	Ret() (_m, _ret string)
+	Woo(fn func(prefix string, values ...int) string) string
 }
 
 type Water struct{}
@@ -48,4 +64,120 @@ type Banana[T, U any] interface {
 	Tree(T)
 	Flower() U
 	Pudding()
-}
\ No newline at end of file
+}
+
+type Database interface {
+	Exec(query string) (sql.Result, error)
+	Last() fsql.Result
+}
+
+type Page[T any] struct {
+	Items []T
+}
+
+type Library interface {
+	Get(p *Page[g.User]) *Page[g.User]
+	List(pages []Page[g.User]) []Page[g.User]
+	Index(pages map[string]*Page[g.User]) map[string]*Page[g.User]
+}
+
+// Feed composes the slices, maps, channels and pointers with the instantiations of the generic types of another package.
+type Feed[T, V any] interface {
+	List() []*j.Page[g.User]
+	Results(ids []g.ID) map[g.ID]*j.Result[V]
+	Events() chan *j.Event[T]
+}
+
+// Hasher uses pointers to fixed-size arrays, record is only imported through the array element.
+type Hasher interface {
+	Hash() *[32]byte
+	Process(entries *[4]record.Entry) error
+}
+
+// Node references itself with its own type parameter.
+type Node[T any] interface {
+	Value() T
+	Children() []Node[T]
+	Parent() (Node[T], bool)
+}
+
+// Grater embeds an interface, it's implemented by pointer receivers.
+type Grater interface {
+	io.Closer
+	Grate(n int) int
+}
+
+type grater struct {
+	grated int
+}
+
+func (g *grater) Grate(n int) int {
+	g.grated += n
+
+	return g.grated
+}
+
+func (g *grater) Close() error {
+	return nil
+}
+
+type Change struct {
+	Path string
+}
+
+type Option func(*Change)
+
+type Applier interface {
+	Apply(changes []Change, opts ...Option)
+}
+
+type Kitchen interface {
+	b.Carrot
+	Cook(p *b.Potato) error
+	Garnish(cherry b.Cherry) error
+}
+
+type Logger interface {
+	Log(args ...any)
+	Logf(format string, args ...interface{}) int
+}
+
+type Cache[K comparable, V any] interface {
+	Get(k K) (V, bool)
+	Set(k K, v V)
+}
+type Ordered[T Ordered[T]] interface {
+	Less(other T) bool
+}
+
+type Number interface {
+	~int | ~float64
+	String() string
+}
+
+type Lemon interface {
+	any
+	interface{}
+	Squeeze(n int) string
+	Zest() (_ int, err error)
+	Juice() any
+	Pulp() map[string]interface{}
+	Address() unsafe.Pointer
+	Pour(handlers map[string]func(context.Context) error) error
+	Peel() func() io.Reader
+	Seeds() <-chan module.Version
+	Pick(time time.Time, module module.Version) (io io.Reader)
+	Register(h *Orange, hooks *interface {
+		io.Closer
+		Origin() module.Version
+	}) error
+	Press() interface {
+		io.Closer
+		Read(p []byte) (n int, err error)
+		Origin() module.Version
+	}
+}
+
+var Handler interface {
+	Handle(ctx context.Context, name string) error
+}
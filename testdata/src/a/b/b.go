@@ -9,6 +9,9 @@ type Carrot interface {
 	Bur(string) *c.Cherry
 }
 
+// Cherry is kept as written (b.Cherry) in the mocks.
+type Cherry = c.Cherry
+
 type Potato struct {
 	Name string
 }
@@ -0,0 +1,5 @@
+package sql
+
+type Result struct {
+	Rows int
+}
@@ -0,0 +1,7 @@
+package g
+
+type User struct {
+	Name string
+}
+
+type ID string
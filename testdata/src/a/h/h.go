@@ -0,0 +1,18 @@
+package h
+
+import (
+	. "a/g"
+)
+
+// g clashes with the name of the dot-imported package.
+const g = "default"
+
+type Registry interface {
+	Add(u User) error
+	Find(name string) (*User, bool)
+	All() []User
+}
+
+func Group() string {
+	return g
+}
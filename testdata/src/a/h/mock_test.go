@@ -0,0 +1,31 @@
+package h
+
+import (
+	"testing"
+
+	. "a/g"
+)
+
+// mocktail:Registry
+
+func TestRegistry(t *testing.T) {
+	user := &User{Name: "bob"}
+
+	var r Registry = newRegistryMock(t).
+		OnAdd(*user).TypedReturns(nil).Once().
+		OnFind("bob").TypedReturns(user, true).Once().
+		OnAll().TypedReturns([]User{*user}).Once().
+		Parent
+
+	if err := r.Add(*user); err != nil {
+		t.Fatal(err)
+	}
+
+	if u, ok := r.Find("bob"); !ok || u.Name != "bob" {
+		t.Fatalf("unexpected user: %v", u)
+	}
+
+	if users := r.All(); len(users) != 1 {
+		t.Fatalf("unexpected users: %v", users)
+	}
+}
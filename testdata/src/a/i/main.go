@@ -0,0 +1,15 @@
+package main
+
+import "fmt"
+
+type Greeting struct {
+	Text string
+}
+
+type Greeter interface {
+	Greet(name string) Greeting
+}
+
+func main() {
+	fmt.Println("hello")
+}
@@ -0,0 +1,15 @@
+package main
+
+import "testing"
+
+// mocktail:Greeter
+
+func TestGreeter(t *testing.T) {
+	var g Greeter = newGreeterMock(t).
+		OnGreet("bob").TypedReturns(Greeting{Text: "hello bob"}).Once().
+		Parent
+
+	if greeting := g.Greet("bob"); greeting.Text != "hello bob" {
+		t.Fatalf("unexpected greeting: %s", greeting.Text)
+	}
+}
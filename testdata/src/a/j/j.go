@@ -0,0 +1,14 @@
+package j
+
+type Page[T any] struct {
+	Items []T
+}
+
+type Result[V any] struct {
+	Value V
+	Err   error
+}
+
+type Event[T any] struct {
+	Payload T
+}
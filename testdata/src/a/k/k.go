@@ -0,0 +1,16 @@
+package k
+
+type (
+	Reader interface {
+		Read() (string, error)
+	}
+
+	Writer interface {
+		Write(s string) error
+	}
+
+	Closer interface {
+		// mocktail:must
+		Close() error
+	}
+)
@@ -0,0 +1,45 @@
+package k
+
+import (
+	"errors"
+	"testing"
+)
+
+// mocktail:Reader, Writer
+// mocktail:Closer
+type (
+	// flusher is declared in the test file, it's not mocked.
+	flusher interface {
+		Flush() error // mocktail:must
+	}
+)
+
+func TestGrouped(t *testing.T) {
+	var r Reader = newReaderMock(t).
+		OnRead().TypedReturns("a", nil).Once().
+		Parent
+
+	if s, err := r.Read(); s != "a" || err != nil {
+		t.Fatalf("unexpected read: %s, %v", s, err)
+	}
+
+	var w Writer = newWriterMock(t).
+		OnWrite("b").TypedReturns(errors.New("closed")).Once().
+		Parent
+
+	if err := w.Write("b"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	var c Closer = newCloserMock(t).
+		OnClose().TypedReturns(nil).Once().
+		Parent
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if f, ok := c.(flusher); ok {
+		t.Fatalf("unexpected flusher: %v", f)
+	}
+}
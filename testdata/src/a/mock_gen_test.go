@@ -1,4 +1,5 @@
 // Code generated by mocktail; DO NOT EDIT.
+// mocktail:hash:605fe6cf0bc6a95d
 
 package a
 
@@ -6,16 +7,23 @@ import (
 	"a/b"
 	"a/c"
 	"a/e/v2"
+	"a/f/sql"
+	"a/g"
+	"a/j"
+	"a/record"
 	"bytes"
 	"context"
+	sql2 "database/sql"
+	"io"
 	"testing"
 	"time"
+	"unsafe"
 
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/mod/module"
 )
 
-// pineappleMock mock of Pineapple.
+// pineappleMock is a mock of the Pineapple interface (generated by mocktail).
 type pineappleMock struct{ mock.Mock }
 
 // newPineappleMock creates a new pineappleMock.
@@ -46,6 +54,21 @@ func (_m *pineappleMock) OnCoo(bParam string, cParam Water) *pineappleCooCall {
 	return &pineappleCooCall{Call: _m.Mock.On("Coo", bParam, cParam), Parent: _m}
 }
 
+// OnCooMatched is like OnCoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *pineappleMock) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if bParam != nil {
+		_args[0] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[1] = mock.MatchedBy(cParam)
+	}
+
+	return &pineappleCooCall{Call: _m.Mock.On("Coo", _args...), Parent: _m}
+}
+
 func (_m *pineappleMock) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return &pineappleCooCall{Call: _m.Mock.On("Coo", bParam, cParam), Parent: _m}
 }
@@ -130,10 +153,22 @@ func (_c *pineappleCooCall) OnNoo() *pineappleNooCall {
 	return _c.Parent.OnNoo()
 }
 
+func (_c *pineappleCooCall) OnPing() *pineapplePingCall {
+	return _c.Parent.OnPing()
+}
+
 func (_c *pineappleCooCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleCooCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleCooCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleCooCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -150,6 +185,10 @@ func (_c *pineappleCooCall) OnNooRaw() *pineappleNooCall {
 	return _c.Parent.OnNooRaw()
 }
 
+func (_c *pineappleCooCall) OnPingRaw() *pineapplePingCall {
+	return _c.Parent.OnPingRaw()
+}
+
 func (_c *pineappleCooCall) OnWorldRaw() *pineappleWorldCall {
 	return _c.Parent.OnWorldRaw()
 }
@@ -221,8 +260,8 @@ func (_c *pineappleGooCall) Maybe() *pineappleGooCall {
 	return _c
 }
 
-func (_c *pineappleGooCall) TypedReturns(a string, b int, c Water) *pineappleGooCall {
-	_c.Call = _c.Return(a, b, c)
+func (_c *pineappleGooCall) TypedReturns(a string, d int, f Water) *pineappleGooCall {
+	_c.Call = _c.Return(a, d, f)
 	return _c
 }
 
@@ -254,10 +293,22 @@ func (_c *pineappleGooCall) OnNoo() *pineappleNooCall {
 	return _c.Parent.OnNoo()
 }
 
+func (_c *pineappleGooCall) OnPing() *pineapplePingCall {
+	return _c.Parent.OnPing()
+}
+
 func (_c *pineappleGooCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleGooCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleGooCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleGooCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -274,6 +325,10 @@ func (_c *pineappleGooCall) OnNooRaw() *pineappleNooCall {
 	return _c.Parent.OnNooRaw()
 }
 
+func (_c *pineappleGooCall) OnPingRaw() *pineapplePingCall {
+	return _c.Parent.OnPingRaw()
+}
+
 func (_c *pineappleGooCall) OnWorldRaw() *pineappleWorldCall {
 	return _c.Parent.OnWorldRaw()
 }
@@ -294,6 +349,17 @@ func (_m *pineappleMock) OnHello(bar Water) *pineappleHelloCall {
 	return &pineappleHelloCall{Call: _m.Mock.On("Hello", bar), Parent: _m}
 }
 
+// OnHelloMatched is like OnHello but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *pineappleMock) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	_args := []interface{}{mock.Anything}
+
+	if bar != nil {
+		_args[0] = mock.MatchedBy(bar)
+	}
+
+	return &pineappleHelloCall{Call: _m.Mock.On("Hello", _args...), Parent: _m}
+}
+
 func (_m *pineappleMock) OnHelloRaw(bar interface{}) *pineappleHelloCall {
 	return &pineappleHelloCall{Call: _m.Mock.On("Hello", bar), Parent: _m}
 }
@@ -377,10 +443,22 @@ func (_c *pineappleHelloCall) OnNoo() *pineappleNooCall {
 	return _c.Parent.OnNoo()
 }
 
+func (_c *pineappleHelloCall) OnPing() *pineapplePingCall {
+	return _c.Parent.OnPing()
+}
+
 func (_c *pineappleHelloCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleHelloCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleHelloCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleHelloCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -397,6 +475,10 @@ func (_c *pineappleHelloCall) OnNooRaw() *pineappleNooCall {
 	return _c.Parent.OnNooRaw()
 }
 
+func (_c *pineappleHelloCall) OnPingRaw() *pineapplePingCall {
+	return _c.Parent.OnPingRaw()
+}
+
 func (_c *pineappleHelloCall) OnWorldRaw() *pineappleWorldCall {
 	return _c.Parent.OnWorldRaw()
 }
@@ -499,10 +581,22 @@ func (_c *pineappleNooCall) OnNoo() *pineappleNooCall {
 	return _c.Parent.OnNoo()
 }
 
+func (_c *pineappleNooCall) OnPing() *pineapplePingCall {
+	return _c.Parent.OnPing()
+}
+
 func (_c *pineappleNooCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleNooCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleNooCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleNooCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -519,10 +613,152 @@ func (_c *pineappleNooCall) OnNooRaw() *pineappleNooCall {
 	return _c.Parent.OnNooRaw()
 }
 
+func (_c *pineappleNooCall) OnPingRaw() *pineapplePingCall {
+	return _c.Parent.OnPingRaw()
+}
+
 func (_c *pineappleNooCall) OnWorldRaw() *pineappleWorldCall {
 	return _c.Parent.OnWorldRaw()
 }
 
+func (_m *pineappleMock) Ping(_ context.Context) error {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() error); ok {
+		return _rf()
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *pineappleMock) OnPing() *pineapplePingCall {
+	return &pineapplePingCall{Call: _m.Mock.On("Ping"), Parent: _m}
+}
+
+func (_m *pineappleMock) OnPingRaw() *pineapplePingCall {
+	return &pineapplePingCall{Call: _m.Mock.On("Ping"), Parent: _m}
+}
+
+type pineapplePingCall struct {
+	*mock.Call
+	Parent *pineappleMock
+}
+
+func (_c *pineapplePingCall) Panic(msg string) *pineapplePingCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *pineapplePingCall) Once() *pineapplePingCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *pineapplePingCall) Twice() *pineapplePingCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *pineapplePingCall) Times(i int) *pineapplePingCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *pineapplePingCall) WaitUntil(w <-chan time.Time) *pineapplePingCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *pineapplePingCall) After(d time.Duration) *pineapplePingCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *pineapplePingCall) Run(fn func(args mock.Arguments)) *pineapplePingCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *pineapplePingCall) Maybe() *pineapplePingCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *pineapplePingCall) TypedReturns(a error) *pineapplePingCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *pineapplePingCall) ReturnsFn(fn func() error) *pineapplePingCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *pineapplePingCall) TypedRun(fn func()) *pineapplePingCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *pineapplePingCall) OnCoo(bParam string, cParam Water) *pineappleCooCall {
+	return _c.Parent.OnCoo(bParam, cParam)
+}
+
+func (_c *pineapplePingCall) OnGoo() *pineappleGooCall {
+	return _c.Parent.OnGoo()
+}
+
+func (_c *pineapplePingCall) OnHello(bar Water) *pineappleHelloCall {
+	return _c.Parent.OnHello(bar)
+}
+
+func (_c *pineapplePingCall) OnNoo() *pineappleNooCall {
+	return _c.Parent.OnNoo()
+}
+
+func (_c *pineapplePingCall) OnPing() *pineapplePingCall {
+	return _c.Parent.OnPing()
+}
+
+func (_c *pineapplePingCall) OnWorld() *pineappleWorldCall {
+	return _c.Parent.OnWorld()
+}
+
+func (_c *pineapplePingCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineapplePingCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
+func (_c *pineapplePingCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
+	return _c.Parent.OnCooRaw(bParam, cParam)
+}
+
+func (_c *pineapplePingCall) OnGooRaw() *pineappleGooCall {
+	return _c.Parent.OnGooRaw()
+}
+
+func (_c *pineapplePingCall) OnHelloRaw(bar interface{}) *pineappleHelloCall {
+	return _c.Parent.OnHelloRaw(bar)
+}
+
+func (_c *pineapplePingCall) OnNooRaw() *pineappleNooCall {
+	return _c.Parent.OnNooRaw()
+}
+
+func (_c *pineapplePingCall) OnPingRaw() *pineapplePingCall {
+	return _c.Parent.OnPingRaw()
+}
+
+func (_c *pineapplePingCall) OnWorldRaw() *pineappleWorldCall {
+	return _c.Parent.OnWorldRaw()
+}
+
 func (_m *pineappleMock) World() string {
 	_ret := _m.Called()
 
@@ -621,10 +857,22 @@ func (_c *pineappleWorldCall) OnNoo() *pineappleNooCall {
 	return _c.Parent.OnNoo()
 }
 
+func (_c *pineappleWorldCall) OnPing() *pineapplePingCall {
+	return _c.Parent.OnPing()
+}
+
 func (_c *pineappleWorldCall) OnWorld() *pineappleWorldCall {
 	return _c.Parent.OnWorld()
 }
 
+func (_c *pineappleWorldCall) OnCooMatched(bParam func(string) bool, cParam func(Water) bool) *pineappleCooCall {
+	return _c.Parent.OnCooMatched(bParam, cParam)
+}
+
+func (_c *pineappleWorldCall) OnHelloMatched(bar func(Water) bool) *pineappleHelloCall {
+	return _c.Parent.OnHelloMatched(bar)
+}
+
 func (_c *pineappleWorldCall) OnCooRaw(bParam interface{}, cParam interface{}) *pineappleCooCall {
 	return _c.Parent.OnCooRaw(bParam, cParam)
 }
@@ -641,11 +889,15 @@ func (_c *pineappleWorldCall) OnNooRaw() *pineappleNooCall {
 	return _c.Parent.OnNooRaw()
 }
 
+func (_c *pineappleWorldCall) OnPingRaw() *pineapplePingCall {
+	return _c.Parent.OnPingRaw()
+}
+
 func (_c *pineappleWorldCall) OnWorldRaw() *pineappleWorldCall {
 	return _c.Parent.OnWorldRaw()
 }
 
-// coconutMock mock of Coconut.
+// coconutMock is a mock of the Coconut interface (generated by mocktail).
 type coconutMock struct{ mock.Mock }
 
 // newCoconutMock creates a new coconutMock.
@@ -676,6 +928,17 @@ func (_m *coconutMock) OnBoo(src *bytes.Buffer) *coconutBooCall {
 	return &coconutBooCall{Call: _m.Mock.On("Boo", src), Parent: _m}
 }
 
+// OnBooMatched is like OnBoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutBooCall{Call: _m.Mock.On("Boo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnBooRaw(src interface{}) *coconutBooCall {
 	return &coconutBooCall{Call: _m.Mock.On("Boo", src), Parent: _m}
 }
@@ -783,10 +1046,34 @@ func (_c *coconutBooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutBooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutBooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutBooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutBooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutBooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutBooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutBooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutBooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -795,14 +1082,98 @@ func (_c *coconutBooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutBooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutBooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutBooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutBooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutBooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutBooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutBooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutBooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutBooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutBooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutBooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutBooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutBooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutBooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutBooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutBooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutBooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutBooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutBooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutBooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutBooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutBooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutBooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutBooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutBooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -843,10 +1214,34 @@ func (_c *coconutBooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutBooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutBooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutBooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutBooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutBooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutBooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutBooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutBooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -855,6 +1250,10 @@ func (_c *coconutBooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutBooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutBooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -879,6 +1278,17 @@ func (_m *coconutMock) OnDoo(src time.Duration) *coconutDooCall {
 	return &coconutDooCall{Call: _m.Mock.On("Doo", src), Parent: _m}
 }
 
+// OnDooMatched is like OnDoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutDooCall{Call: _m.Mock.On("Doo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnDooRaw(src interface{}) *coconutDooCall {
 	return &coconutDooCall{Call: _m.Mock.On("Doo", src), Parent: _m}
 }
@@ -986,10 +1396,34 @@ func (_c *coconutDooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutDooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutDooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutDooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutDooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutDooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutDooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutDooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutDooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -998,35 +1432,119 @@ func (_c *coconutDooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutDooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutDooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutDooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutDooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
-func (_c *coconutDooCall) OnBooRaw(src interface{}) *coconutBooCall {
-	return _c.Parent.OnBooRaw(src)
+func (_c *coconutDooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
 }
 
-func (_c *coconutDooCall) OnDooRaw(src interface{}) *coconutDooCall {
-	return _c.Parent.OnDooRaw(src)
+func (_c *coconutDooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
 }
 
-func (_c *coconutDooCall) OnFooRaw(st interface{}) *coconutFooCall {
-	return _c.Parent.OnFooRaw(st)
+func (_c *coconutDooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
 }
 
-func (_c *coconutDooCall) OnGooRaw(st interface{}) *coconutGooCall {
-	return _c.Parent.OnGooRaw(st)
+func (_c *coconutDooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
 }
 
-func (_c *coconutDooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
-	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+func (_c *coconutDooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
 }
 
-func (_c *coconutDooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+func (_c *coconutDooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutDooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutDooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutDooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutDooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutDooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutDooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutDooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutDooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutDooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutDooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutDooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutDooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutDooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutDooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutDooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutDooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutDooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutDooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutDooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutDooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
 	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
 }
 
@@ -1046,10 +1564,34 @@ func (_c *coconutDooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutDooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutDooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutDooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutDooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutDooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutDooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutDooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutDooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -1058,6 +1600,10 @@ func (_c *coconutDooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutDooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutDooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -1082,6 +1628,17 @@ func (_m *coconutMock) OnFoo(st Strawberry) *coconutFooCall {
 	return &coconutFooCall{Call: _m.Mock.On("Foo", st), Parent: _m}
 }
 
+// OnFooMatched is like OnFoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutFooCall{Call: _m.Mock.On("Foo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnFooRaw(st interface{}) *coconutFooCall {
 	return &coconutFooCall{Call: _m.Mock.On("Foo", st), Parent: _m}
 }
@@ -1189,10 +1746,34 @@ func (_c *coconutFooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutFooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutFooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutFooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutFooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutFooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutFooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutFooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutFooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -1201,14 +1782,98 @@ func (_c *coconutFooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutFooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutFooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutFooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutFooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutFooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutFooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutFooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutFooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutFooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutFooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutFooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutFooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutFooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutFooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutFooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutFooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutFooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutFooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutFooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutFooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutFooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutFooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutFooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutFooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutFooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1249,10 +1914,34 @@ func (_c *coconutFooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutFooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutFooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutFooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutFooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutFooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutFooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutFooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutFooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -1261,6 +1950,10 @@ func (_c *coconutFooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutFooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutFooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -1285,6 +1978,17 @@ func (_m *coconutMock) OnGoo(st string) *coconutGooCall {
 	return &coconutGooCall{Call: _m.Mock.On("Goo", st), Parent: _m}
 }
 
+// OnGooMatched is like OnGoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnGooMatched(st func(string) bool) *coconutGooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutGooCall{Call: _m.Mock.On("Goo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnGooRaw(st interface{}) *coconutGooCall {
 	return &coconutGooCall{Call: _m.Mock.On("Goo", st), Parent: _m}
 }
@@ -1392,10 +2096,34 @@ func (_c *coconutGooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutGooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutGooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutGooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutGooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutGooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutGooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutGooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutGooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -1404,14 +2132,98 @@ func (_c *coconutGooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutGooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutGooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutGooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutGooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutGooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutGooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutGooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutGooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutGooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutGooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutGooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutGooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutGooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutGooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutGooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutGooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutGooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutGooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutGooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutGooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutGooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutGooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutGooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutGooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutGooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1452,10 +2264,34 @@ func (_c *coconutGooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutGooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutGooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutGooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutGooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutGooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutGooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutGooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutGooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -1464,6 +2300,10 @@ func (_c *coconutGooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutGooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutGooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -1480,6 +2320,25 @@ func (_m *coconutMock) OnHoo(aParam string, bParam int, cParam Water) *coconutHo
 	return &coconutHooCall{Call: _m.Mock.On("Hoo", aParam, bParam, cParam), Parent: _m}
 }
 
+// OnHooMatched is like OnHoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	_args := []interface{}{mock.Anything, mock.Anything, mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	if bParam != nil {
+		_args[1] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[2] = mock.MatchedBy(cParam)
+	}
+
+	return &coconutHooCall{Call: _m.Mock.On("Hoo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
 	return &coconutHooCall{Call: _m.Mock.On("Hoo", aParam, bParam, cParam), Parent: _m}
 }
@@ -1579,10 +2438,34 @@ func (_c *coconutHooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutHooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutHooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutHooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutHooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutHooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutHooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutHooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutHooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -1591,56 +2474,164 @@ func (_c *coconutHooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutHooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutHooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutHooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutHooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
-func (_c *coconutHooCall) OnBooRaw(src interface{}) *coconutBooCall {
-	return _c.Parent.OnBooRaw(src)
+func (_c *coconutHooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
 }
 
-func (_c *coconutHooCall) OnDooRaw(src interface{}) *coconutDooCall {
-	return _c.Parent.OnDooRaw(src)
+func (_c *coconutHooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
 }
 
-func (_c *coconutHooCall) OnFooRaw(st interface{}) *coconutFooCall {
-	return _c.Parent.OnFooRaw(st)
+func (_c *coconutHooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
 }
 
-func (_c *coconutHooCall) OnGooRaw(st interface{}) *coconutGooCall {
-	return _c.Parent.OnGooRaw(st)
+func (_c *coconutHooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
 }
 
-func (_c *coconutHooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
-	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+func (_c *coconutHooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
 }
 
-func (_c *coconutHooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
-	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+func (_c *coconutHooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
 }
 
-func (_c *coconutHooCall) OnKooRaw(src interface{}) *coconutKooCall {
-	return _c.Parent.OnKooRaw(src)
+func (_c *coconutHooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
 }
 
-func (_c *coconutHooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
-	return _c.Parent.OnLooRaw(st, values)
+func (_c *coconutHooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
 }
 
-func (_c *coconutHooCall) OnMooRaw(fn interface{}) *coconutMooCall {
-	return _c.Parent.OnMooRaw(fn)
+func (_c *coconutHooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
 }
 
-func (_c *coconutHooCall) OnNooRaw(ar interface{}) *coconutNooCall {
-	return _c.Parent.OnNooRaw(ar)
+func (_c *coconutHooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
 }
 
-func (_c *coconutHooCall) OnPooRaw(str interface{}) *coconutPooCall {
-	return _c.Parent.OnPooRaw(str)
+func (_c *coconutHooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutHooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutHooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutHooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutHooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutHooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutHooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutHooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutHooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutHooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutHooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutHooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutHooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutHooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutHooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutHooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutHooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutHooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutHooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutHooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutHooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutHooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutHooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutHooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutHooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutHooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutHooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
 }
 
 func (_c *coconutHooCall) OnTooRaw(src interface{}) *coconutTooCall {
@@ -1651,6 +2642,10 @@ func (_c *coconutHooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutHooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutHooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -1676,6 +2671,25 @@ func (_m *coconutMock) OnJoo(aParam string, bParam int, cParam Water) *coconutJo
 	return &coconutJooCall{Call: _m.Mock.On("Joo", aParam, bParam, cParam), Parent: _m}
 }
 
+// OnJooMatched is like OnJoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	_args := []interface{}{mock.Anything, mock.Anything, mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	if bParam != nil {
+		_args[1] = mock.MatchedBy(bParam)
+	}
+
+	if cParam != nil {
+		_args[2] = mock.MatchedBy(cParam)
+	}
+
+	return &coconutJooCall{Call: _m.Mock.On("Joo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
 	return &coconutJooCall{Call: _m.Mock.On("Joo", aParam, bParam, cParam), Parent: _m}
 }
@@ -1725,8 +2739,8 @@ func (_c *coconutJooCall) Maybe() *coconutJooCall {
 	return _c
 }
 
-func (_c *coconutJooCall) TypedReturns(a string, b int) *coconutJooCall {
-	_c.Call = _c.Return(a, b)
+func (_c *coconutJooCall) TypedReturns(a string, d int) *coconutJooCall {
+	_c.Call = _c.Return(a, d)
 	return _c
 }
 
@@ -1785,10 +2799,34 @@ func (_c *coconutJooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutJooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutJooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutJooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutJooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutJooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutJooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutJooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutJooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -1797,14 +2835,98 @@ func (_c *coconutJooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutJooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutJooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutJooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutJooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutJooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutJooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutJooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutJooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutJooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutJooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutJooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutJooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutJooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutJooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutJooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutJooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutJooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutJooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutJooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutJooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutJooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutJooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutJooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutJooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutJooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -1845,10 +2967,34 @@ func (_c *coconutJooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutJooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutJooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutJooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutJooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutJooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutJooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutJooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutJooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -1857,6 +3003,10 @@ func (_c *coconutJooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutJooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutJooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -1881,6 +3031,17 @@ func (_m *coconutMock) OnKoo(src string) *coconutKooCall {
 	return &coconutKooCall{Call: _m.Mock.On("Koo", src), Parent: _m}
 }
 
+// OnKooMatched is like OnKoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnKooMatched(src func(string) bool) *coconutKooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutKooCall{Call: _m.Mock.On("Koo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnKooRaw(src interface{}) *coconutKooCall {
 	return &coconutKooCall{Call: _m.Mock.On("Koo", src), Parent: _m}
 }
@@ -1988,10 +3149,34 @@ func (_c *coconutKooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutKooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutKooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutKooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutKooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutKooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutKooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutKooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutKooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -2000,14 +3185,98 @@ func (_c *coconutKooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutKooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutKooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutKooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutKooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutKooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutKooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutKooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutKooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutKooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutKooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutKooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutKooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutKooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutKooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutKooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutKooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutKooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutKooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutKooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutKooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutKooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutKooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutKooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutKooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutKooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2048,10 +3317,34 @@ func (_c *coconutKooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutKooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutKooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutKooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutKooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutKooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutKooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutKooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutKooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -2060,6 +3353,10 @@ func (_c *coconutKooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutKooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutKooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -2084,6 +3381,21 @@ func (_m *coconutMock) OnLoo(st string, values ...int) *coconutLooCall {
 	return &coconutLooCall{Call: _m.Mock.On("Loo", st, values), Parent: _m}
 }
 
+// OnLooMatched is like OnLoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	if values != nil {
+		_args[1] = mock.MatchedBy(values)
+	}
+
+	return &coconutLooCall{Call: _m.Mock.On("Loo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
 	return &coconutLooCall{Call: _m.Mock.On("Loo", st, values), Parent: _m}
 }
@@ -2192,10 +3504,34 @@ func (_c *coconutLooCall) OnNoo(ar ...[2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutLooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutLooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutLooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutLooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutLooCall) OnRoo(errs ...error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutLooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutLooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutLooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -2204,31 +3540,115 @@ func (_c *coconutLooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutLooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutLooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutLooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutLooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
-func (_c *coconutLooCall) OnBooRaw(src interface{}) *coconutBooCall {
-	return _c.Parent.OnBooRaw(src)
+func (_c *coconutLooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
 }
 
-func (_c *coconutLooCall) OnDooRaw(src interface{}) *coconutDooCall {
-	return _c.Parent.OnDooRaw(src)
+func (_c *coconutLooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
 }
 
-func (_c *coconutLooCall) OnFooRaw(st interface{}) *coconutFooCall {
-	return _c.Parent.OnFooRaw(st)
+func (_c *coconutLooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
 }
 
-func (_c *coconutLooCall) OnGooRaw(st interface{}) *coconutGooCall {
-	return _c.Parent.OnGooRaw(st)
+func (_c *coconutLooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
 }
 
-func (_c *coconutLooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+func (_c *coconutLooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutLooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutLooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutLooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutLooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutLooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutLooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutLooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutLooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutLooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutLooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutLooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutLooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutLooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutLooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutLooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutLooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutLooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutLooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutLooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutLooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
 	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
 }
 
@@ -2252,10 +3672,34 @@ func (_c *coconutLooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutLooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutLooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutLooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutLooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutLooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutLooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutLooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutLooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -2264,6 +3708,10 @@ func (_c *coconutLooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutLooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutLooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -2288,6 +3736,17 @@ func (_m *coconutMock) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconut
 	return &coconutMooCall{Call: _m.Mock.On("Moo", mock.Anything), Parent: _m}
 }
 
+// OnMooMatched is like OnMoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	_args := []interface{}{mock.Anything}
+
+	if fn != nil {
+		_args[0] = mock.MatchedBy(fn)
+	}
+
+	return &coconutMooCall{Call: _m.Mock.On("Moo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnMooRaw(fn interface{}) *coconutMooCall {
 	return &coconutMooCall{Call: _m.Mock.On("Moo", mock.Anything), Parent: _m}
 }
@@ -2395,10 +3854,34 @@ func (_c *coconutMooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutMooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutMooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutMooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutMooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutMooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutMooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutMooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutMooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -2407,14 +3890,98 @@ func (_c *coconutMooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutMooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutMooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutMooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutMooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutMooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutMooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutMooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutMooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutMooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutMooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutMooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutMooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutMooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutMooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutMooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutMooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutMooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutMooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutMooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutMooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutMooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutMooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutMooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutMooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutMooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2455,10 +4022,34 @@ func (_c *coconutMooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutMooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutMooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutMooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutMooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutMooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutMooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutMooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutMooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -2467,6 +4058,10 @@ func (_c *coconutMooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutMooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutMooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -2491,6 +4086,17 @@ func (_m *coconutMock) OnNoo(ar [][2]string) *coconutNooCall {
 	return &coconutNooCall{Call: _m.Mock.On("Noo", ar), Parent: _m}
 }
 
+// OnNooMatched is like OnNoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	_args := []interface{}{mock.Anything}
+
+	if ar != nil {
+		_args[0] = mock.MatchedBy(ar)
+	}
+
+	return &coconutNooCall{Call: _m.Mock.On("Noo", _args...), Parent: _m}
+}
+
 func (_m *coconutMock) OnNooRaw(ar interface{}) *coconutNooCall {
 	return &coconutNooCall{Call: _m.Mock.On("Noo", ar), Parent: _m}
 }
@@ -2598,10 +4204,34 @@ func (_c *coconutNooCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
+func (_c *coconutNooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
 func (_c *coconutNooCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
+func (_c *coconutNooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutNooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutNooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutNooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutNooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
 func (_c *coconutNooCall) OnToo(src string) *coconutTooCall {
 	return _c.Parent.OnToo(src)
 }
@@ -2610,14 +4240,98 @@ func (_c *coconutNooCall) OnVoo(src *module.Version) *coconutVooCall {
 	return _c.Parent.OnVoo(src)
 }
 
+func (_c *coconutNooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
 func (_c *coconutNooCall) OnYoo(st string) *coconutYooCall {
 	return _c.Parent.OnYoo(st)
 }
 
-func (_c *coconutNooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutNooCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
+func (_c *coconutNooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutNooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutNooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutNooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutNooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutNooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutNooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutNooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutNooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutNooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutNooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutNooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutNooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutNooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutNooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutNooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutNooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutNooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutNooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutNooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
 func (_c *coconutNooCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
@@ -2658,10 +4372,34 @@ func (_c *coconutNooCall) OnNooRaw(ar interface{}) *coconutNooCall {
 	return _c.Parent.OnNooRaw(ar)
 }
 
+func (_c *coconutNooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
 func (_c *coconutNooCall) OnPooRaw(str interface{}) *coconutPooCall {
 	return _c.Parent.OnPooRaw(str)
 }
 
+func (_c *coconutNooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutNooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutNooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutNooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutNooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
 func (_c *coconutNooCall) OnTooRaw(src interface{}) *coconutTooCall {
 	return _c.Parent.OnTooRaw(src)
 }
@@ -2670,6 +4408,10 @@ func (_c *coconutNooCall) OnVooRaw(src interface{}) *coconutVooCall {
 	return _c.Parent.OnVooRaw(src)
 }
 
+func (_c *coconutNooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
 func (_c *coconutNooCall) OnYooRaw(st interface{}) *coconutYooCall {
 	return _c.Parent.OnYooRaw(st)
 }
@@ -2678,1029 +4420,9520 @@ func (_c *coconutNooCall) OnZooRaw(st interface{}) *coconutZooCall {
 	return _c.Parent.OnZooRaw(st)
 }
 
-func (_m *coconutMock) Poo(str struct{ name string }) string {
-	_ret := _m.Called(str)
+func (_m *coconutMock) Pair() (string, string) {
+	_ret := _m.Called()
 
-	if _rf, ok := _ret.Get(0).(func(struct{ name string }) string); ok {
-		return _rf(str)
+	if _rf, ok := _ret.Get(0).(func() (string, string)); ok {
+		return _rf()
 	}
 
 	_ra0 := _ret.String(0)
+	_rb1 := _ret.String(1)
 
-	return _ra0
+	return _ra0, _rb1
 }
 
-func (_m *coconutMock) OnPoo(str struct{ name string }) *coconutPooCall {
-	return &coconutPooCall{Call: _m.Mock.On("Poo", str), Parent: _m}
+func (_m *coconutMock) OnPair() *coconutPairCall {
+	return &coconutPairCall{Call: _m.Mock.On("Pair"), Parent: _m}
 }
 
-func (_m *coconutMock) OnPooRaw(str interface{}) *coconutPooCall {
-	return &coconutPooCall{Call: _m.Mock.On("Poo", str), Parent: _m}
+func (_m *coconutMock) OnPairRaw() *coconutPairCall {
+	return &coconutPairCall{Call: _m.Mock.On("Pair"), Parent: _m}
 }
 
-type coconutPooCall struct {
+type coconutPairCall struct {
 	*mock.Call
 	Parent *coconutMock
 }
 
-func (_c *coconutPooCall) Panic(msg string) *coconutPooCall {
+func (_c *coconutPairCall) Panic(msg string) *coconutPairCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *coconutPooCall) Once() *coconutPooCall {
+func (_c *coconutPairCall) Once() *coconutPairCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *coconutPooCall) Twice() *coconutPooCall {
+func (_c *coconutPairCall) Twice() *coconutPairCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *coconutPooCall) Times(i int) *coconutPooCall {
+func (_c *coconutPairCall) Times(i int) *coconutPairCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *coconutPooCall) WaitUntil(w <-chan time.Time) *coconutPooCall {
+func (_c *coconutPairCall) WaitUntil(w <-chan time.Time) *coconutPairCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *coconutPooCall) After(d time.Duration) *coconutPooCall {
+func (_c *coconutPairCall) After(d time.Duration) *coconutPairCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *coconutPooCall) Run(fn func(args mock.Arguments)) *coconutPooCall {
+func (_c *coconutPairCall) Run(fn func(args mock.Arguments)) *coconutPairCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *coconutPooCall) Maybe() *coconutPooCall {
+func (_c *coconutPairCall) Maybe() *coconutPairCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *coconutPooCall) TypedReturns(a string) *coconutPooCall {
-	_c.Call = _c.Return(a)
+func (_c *coconutPairCall) TypedReturns(a string, d string) *coconutPairCall {
+	_c.Call = _c.Return(a, d)
 	return _c
 }
 
-func (_c *coconutPooCall) ReturnsFn(fn func(struct{ name string }) string) *coconutPooCall {
+func (_c *coconutPairCall) ReturnsFn(fn func() (string, string)) *coconutPairCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *coconutPooCall) TypedRun(fn func(struct{ name string })) *coconutPooCall {
+func (_c *coconutPairCall) TypedRun(fn func()) *coconutPairCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_str, _ := args.Get(0).(struct{ name string })
-		fn(_str)
+		fn()
 	})
 	return _c
 }
 
-func (_c *coconutPooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+func (_c *coconutPairCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
 	return _c.Parent.OnBoo(src)
 }
 
-func (_c *coconutPooCall) OnDoo(src time.Duration) *coconutDooCall {
+func (_c *coconutPairCall) OnDoo(src time.Duration) *coconutDooCall {
 	return _c.Parent.OnDoo(src)
 }
 
-func (_c *coconutPooCall) OnFoo(st Strawberry) *coconutFooCall {
+func (_c *coconutPairCall) OnFoo(st Strawberry) *coconutFooCall {
 	return _c.Parent.OnFoo(st)
 }
 
-func (_c *coconutPooCall) OnGoo(st string) *coconutGooCall {
+func (_c *coconutPairCall) OnGoo(st string) *coconutGooCall {
 	return _c.Parent.OnGoo(st)
 }
 
-func (_c *coconutPooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+func (_c *coconutPairCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
 	return _c.Parent.OnHoo(aParam, bParam, cParam)
 }
 
-func (_c *coconutPooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+func (_c *coconutPairCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
 	return _c.Parent.OnJoo(aParam, bParam, cParam)
 }
 
-func (_c *coconutPooCall) OnKoo(src string) *coconutKooCall {
+func (_c *coconutPairCall) OnKoo(src string) *coconutKooCall {
 	return _c.Parent.OnKoo(src)
 }
 
-func (_c *coconutPooCall) OnLoo(st string, values []int) *coconutLooCall {
+func (_c *coconutPairCall) OnLoo(st string, values []int) *coconutLooCall {
 	return _c.Parent.OnLoo(st, values...)
 }
 
-func (_c *coconutPooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+func (_c *coconutPairCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
 	return _c.Parent.OnMoo(fn)
 }
 
-func (_c *coconutPooCall) OnNoo(ar [][2]string) *coconutNooCall {
+func (_c *coconutPairCall) OnNoo(ar [][2]string) *coconutNooCall {
 	return _c.Parent.OnNoo(ar)
 }
 
-func (_c *coconutPooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+func (_c *coconutPairCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutPairCall) OnPoo(str struct{ name string }) *coconutPooCall {
 	return _c.Parent.OnPoo(str)
 }
 
-func (_c *coconutPooCall) OnToo(src string) *coconutTooCall {
-	return _c.Parent.OnToo(src)
+func (_c *coconutPairCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
 }
 
-func (_c *coconutPooCall) OnVoo(src *module.Version) *coconutVooCall {
-	return _c.Parent.OnVoo(src)
+func (_c *coconutPairCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
 }
 
-func (_c *coconutPooCall) OnYoo(st string) *coconutYooCall {
-	return _c.Parent.OnYoo(st)
+func (_c *coconutPairCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
 }
 
-func (_c *coconutPooCall) OnZoo(st interface{}) *coconutZooCall {
+func (_c *coconutPairCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutPairCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutPairCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutPairCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutPairCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutPairCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutPairCall) OnZoo(st any) *coconutZooCall {
 	return _c.Parent.OnZoo(st)
 }
 
-func (_c *coconutPooCall) OnBooRaw(src interface{}) *coconutBooCall {
+func (_c *coconutPairCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutPairCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutPairCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutPairCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutPairCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutPairCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutPairCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutPairCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutPairCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutPairCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutPairCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutPairCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutPairCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutPairCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutPairCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutPairCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutPairCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutPairCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutPairCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutPairCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutPairCall) OnBooRaw(src interface{}) *coconutBooCall {
 	return _c.Parent.OnBooRaw(src)
 }
 
-func (_c *coconutPooCall) OnDooRaw(src interface{}) *coconutDooCall {
+func (_c *coconutPairCall) OnDooRaw(src interface{}) *coconutDooCall {
 	return _c.Parent.OnDooRaw(src)
 }
 
-func (_c *coconutPooCall) OnFooRaw(st interface{}) *coconutFooCall {
+func (_c *coconutPairCall) OnFooRaw(st interface{}) *coconutFooCall {
 	return _c.Parent.OnFooRaw(st)
 }
 
-func (_c *coconutPooCall) OnGooRaw(st interface{}) *coconutGooCall {
+func (_c *coconutPairCall) OnGooRaw(st interface{}) *coconutGooCall {
 	return _c.Parent.OnGooRaw(st)
 }
 
-func (_c *coconutPooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+func (_c *coconutPairCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
 	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
 }
 
-func (_c *coconutPooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+func (_c *coconutPairCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
 	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
 }
 
-func (_c *coconutPooCall) OnKooRaw(src interface{}) *coconutKooCall {
+func (_c *coconutPairCall) OnKooRaw(src interface{}) *coconutKooCall {
 	return _c.Parent.OnKooRaw(src)
 }
 
-func (_c *coconutPooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
-	return _c.Parent.OnLooRaw(st, values)
+func (_c *coconutPairCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutPairCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutPairCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutPairCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutPairCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutPairCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutPairCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutPairCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutPairCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutPairCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutPairCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutPairCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutPairCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutPairCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutPairCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Poo(str struct{ name string }) string {
+	_ret := _m.Called(str)
+
+	if _rf, ok := _ret.Get(0).(func(struct{ name string }) string); ok {
+		return _rf(str)
+	}
+
+	_ra0 := _ret.String(0)
+
+	return _ra0
+}
+
+func (_m *coconutMock) OnPoo(str struct{ name string }) *coconutPooCall {
+	return &coconutPooCall{Call: _m.Mock.On("Poo", str), Parent: _m}
+}
+
+// OnPooMatched is like OnPoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	_args := []interface{}{mock.Anything}
+
+	if str != nil {
+		_args[0] = mock.MatchedBy(str)
+	}
+
+	return &coconutPooCall{Call: _m.Mock.On("Poo", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnPooRaw(str interface{}) *coconutPooCall {
+	return &coconutPooCall{Call: _m.Mock.On("Poo", str), Parent: _m}
+}
+
+type coconutPooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutPooCall) Panic(msg string) *coconutPooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutPooCall) Once() *coconutPooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutPooCall) Twice() *coconutPooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutPooCall) Times(i int) *coconutPooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutPooCall) WaitUntil(w <-chan time.Time) *coconutPooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutPooCall) After(d time.Duration) *coconutPooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutPooCall) Run(fn func(args mock.Arguments)) *coconutPooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutPooCall) Maybe() *coconutPooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutPooCall) TypedReturns(a string) *coconutPooCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *coconutPooCall) ReturnsFn(fn func(struct{ name string }) string) *coconutPooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutPooCall) TypedRun(fn func(struct{ name string })) *coconutPooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_str, _ := args.Get(0).(struct{ name string })
+		fn(_str)
+	})
+	return _c
+}
+
+func (_c *coconutPooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutPooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutPooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutPooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutPooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutPooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutPooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutPooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutPooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutPooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutPooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutPooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutPooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutPooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutPooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutPooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutPooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutPooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutPooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutPooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutPooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutPooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutPooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutPooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutPooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutPooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutPooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutPooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutPooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutPooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutPooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutPooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutPooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutPooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutPooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutPooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutPooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutPooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutPooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutPooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutPooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutPooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutPooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutPooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutPooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutPooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutPooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutPooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutPooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutPooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutPooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutPooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutPooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutPooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutPooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutPooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutPooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutPooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutPooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutPooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutPooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutPooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutPooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutPooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Qoo(a string, c_ int) (string, int, bool, error, Water, []byte) {
+	_ret := _m.Called(a, c_)
+
+	if _rf, ok := _ret.Get(0).(func(string, int) (string, int, bool, error, Water, []byte)); ok {
+		return _rf(a, c_)
+	}
+
+	_ra0 := _ret.String(0)
+	_rb1 := _ret.Int(1)
+	_rc2 := _ret.Bool(2)
+	_rd3 := _ret.Error(3)
+	_re4, _ := _ret.Get(4).(Water)
+	_rf5, _ := _ret.Get(5).([]byte)
+
+	return _ra0, _rb1, _rc2, _rd3, _re4, _rf5
+}
+
+func (_m *coconutMock) OnQoo(a string, c_ int) *coconutQooCall {
+	return &coconutQooCall{Call: _m.Mock.On("Qoo", a, c_), Parent: _m}
+}
+
+// OnQooMatched is like OnQoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if a != nil {
+		_args[0] = mock.MatchedBy(a)
+	}
+
+	if c_ != nil {
+		_args[1] = mock.MatchedBy(c_)
+	}
+
+	return &coconutQooCall{Call: _m.Mock.On("Qoo", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return &coconutQooCall{Call: _m.Mock.On("Qoo", a, c_), Parent: _m}
+}
+
+type coconutQooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutQooCall) Panic(msg string) *coconutQooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutQooCall) Once() *coconutQooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutQooCall) Twice() *coconutQooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutQooCall) Times(i int) *coconutQooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutQooCall) WaitUntil(w <-chan time.Time) *coconutQooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutQooCall) After(d time.Duration) *coconutQooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutQooCall) Run(fn func(args mock.Arguments)) *coconutQooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutQooCall) Maybe() *coconutQooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutQooCall) TypedReturns(d string, f int, h bool, i error, k Water, l []byte) *coconutQooCall {
+	_c.Call = _c.Return(d, f, h, i, k, l)
+	return _c
+}
+
+func (_c *coconutQooCall) ReturnsFn(fn func(string, int) (string, int, bool, error, Water, []byte)) *coconutQooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutQooCall) TypedRun(fn func(string, int)) *coconutQooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_a := args.String(0)
+		_c_ := args.Int(1)
+		fn(_a, _c_)
+	})
+	return _c
+}
+
+func (_c *coconutQooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutQooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutQooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutQooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutQooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutQooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutQooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutQooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutQooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutQooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutQooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutQooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutQooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutQooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutQooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutQooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutQooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutQooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutQooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutQooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutQooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutQooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutQooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutQooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutQooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutQooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutQooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutQooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutQooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutQooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutQooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutQooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutQooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutQooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutQooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutQooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutQooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutQooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutQooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutQooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutQooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutQooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutQooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutQooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutQooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutQooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutQooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutQooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutQooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutQooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutQooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutQooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutQooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutQooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutQooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutQooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutQooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutQooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutQooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutQooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutQooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutQooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutQooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutQooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Ret() (string, string) {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() (string, string)); ok {
+		return _rf()
+	}
+
+	_ra0 := _ret.String(0)
+	_rb1 := _ret.String(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *coconutMock) OnRet() *coconutRetCall {
+	return &coconutRetCall{Call: _m.Mock.On("Ret"), Parent: _m}
+}
+
+func (_m *coconutMock) OnRetRaw() *coconutRetCall {
+	return &coconutRetCall{Call: _m.Mock.On("Ret"), Parent: _m}
+}
+
+type coconutRetCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutRetCall) Panic(msg string) *coconutRetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutRetCall) Once() *coconutRetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutRetCall) Twice() *coconutRetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutRetCall) Times(i int) *coconutRetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutRetCall) WaitUntil(w <-chan time.Time) *coconutRetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutRetCall) After(d time.Duration) *coconutRetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutRetCall) Run(fn func(args mock.Arguments)) *coconutRetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutRetCall) Maybe() *coconutRetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutRetCall) TypedReturns(a string, d string) *coconutRetCall {
+	_c.Call = _c.Return(a, d)
+	return _c
+}
+
+func (_c *coconutRetCall) ReturnsFn(fn func() (string, string)) *coconutRetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutRetCall) TypedRun(fn func()) *coconutRetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *coconutRetCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutRetCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutRetCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutRetCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutRetCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutRetCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutRetCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutRetCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutRetCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutRetCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutRetCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutRetCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutRetCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutRetCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutRetCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutRetCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutRetCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutRetCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutRetCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutRetCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutRetCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutRetCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutRetCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutRetCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutRetCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutRetCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutRetCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutRetCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutRetCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutRetCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutRetCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutRetCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutRetCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutRetCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutRetCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutRetCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutRetCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutRetCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutRetCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutRetCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutRetCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutRetCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutRetCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutRetCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutRetCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutRetCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutRetCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutRetCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutRetCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutRetCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutRetCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutRetCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutRetCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutRetCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutRetCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutRetCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutRetCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutRetCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutRetCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutRetCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutRetCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutRetCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutRetCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutRetCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Roo(errs []error) []error {
+	_ret := _m.Called(errs)
+
+	if _rf, ok := _ret.Get(0).(func([]error) []error); ok {
+		return _rf(errs)
+	}
+
+	_ra0, _ := _ret.Get(0).([]error)
+
+	return _ra0
+}
+
+func (_m *coconutMock) OnRoo(errs []error) *coconutRooCall {
+	return &coconutRooCall{Call: _m.Mock.On("Roo", errs), Parent: _m}
+}
+
+// OnRooMatched is like OnRoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	_args := []interface{}{mock.Anything}
+
+	if errs != nil {
+		_args[0] = mock.MatchedBy(errs)
+	}
+
+	return &coconutRooCall{Call: _m.Mock.On("Roo", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnRooRaw(errs interface{}) *coconutRooCall {
+	return &coconutRooCall{Call: _m.Mock.On("Roo", errs), Parent: _m}
+}
+
+type coconutRooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutRooCall) Panic(msg string) *coconutRooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutRooCall) Once() *coconutRooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutRooCall) Twice() *coconutRooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutRooCall) Times(i int) *coconutRooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutRooCall) WaitUntil(w <-chan time.Time) *coconutRooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutRooCall) After(d time.Duration) *coconutRooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutRooCall) Run(fn func(args mock.Arguments)) *coconutRooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutRooCall) Maybe() *coconutRooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutRooCall) TypedReturns(a []error) *coconutRooCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *coconutRooCall) ReturnsFn(fn func([]error) []error) *coconutRooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutRooCall) TypedRun(fn func([]error)) *coconutRooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_errs, _ := args.Get(0).([]error)
+		fn(_errs)
+	})
+	return _c
+}
+
+func (_c *coconutRooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutRooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutRooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutRooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutRooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutRooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutRooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutRooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutRooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutRooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutRooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutRooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutRooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutRooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutRooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutRooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutRooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutRooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutRooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutRooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutRooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutRooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutRooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutRooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutRooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutRooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutRooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutRooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutRooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutRooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutRooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutRooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutRooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutRooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutRooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutRooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutRooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutRooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutRooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutRooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutRooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutRooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutRooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutRooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutRooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutRooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutRooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutRooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutRooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutRooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutRooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutRooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutRooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutRooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutRooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutRooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutRooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutRooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutRooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutRooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutRooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutRooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutRooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutRooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Soo(errs map[string]error) (map[string]error, error) {
+	_ret := _m.Called(errs)
+
+	if _rf, ok := _ret.Get(0).(func(map[string]error) (map[string]error, error)); ok {
+		return _rf(errs)
+	}
+
+	_ra0, _ := _ret.Get(0).(map[string]error)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *coconutMock) OnSoo(errs map[string]error) *coconutSooCall {
+	return &coconutSooCall{Call: _m.Mock.On("Soo", errs), Parent: _m}
+}
+
+// OnSooMatched is like OnSoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	_args := []interface{}{mock.Anything}
+
+	if errs != nil {
+		_args[0] = mock.MatchedBy(errs)
+	}
+
+	return &coconutSooCall{Call: _m.Mock.On("Soo", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnSooRaw(errs interface{}) *coconutSooCall {
+	return &coconutSooCall{Call: _m.Mock.On("Soo", errs), Parent: _m}
+}
+
+type coconutSooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutSooCall) Panic(msg string) *coconutSooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutSooCall) Once() *coconutSooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutSooCall) Twice() *coconutSooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutSooCall) Times(i int) *coconutSooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutSooCall) WaitUntil(w <-chan time.Time) *coconutSooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutSooCall) After(d time.Duration) *coconutSooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutSooCall) Run(fn func(args mock.Arguments)) *coconutSooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutSooCall) Maybe() *coconutSooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutSooCall) TypedReturns(a map[string]error, d error) *coconutSooCall {
+	_c.Call = _c.Return(a, d)
+	return _c
+}
+
+func (_c *coconutSooCall) ReturnsFn(fn func(map[string]error) (map[string]error, error)) *coconutSooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+// TypedReturnsError is like TypedReturns but returns the zero values with the error.
+func (_c *coconutSooCall) TypedReturnsError(err error) *coconutSooCall {
+	var a map[string]error
+
+	_c.Call = _c.Return(a, err)
+	return _c
+}
+
+func (_c *coconutSooCall) TypedRun(fn func(map[string]error)) *coconutSooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_errs, _ := args.Get(0).(map[string]error)
+		fn(_errs)
+	})
+	return _c
+}
+
+func (_c *coconutSooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutSooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutSooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutSooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutSooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutSooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutSooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutSooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutSooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutSooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutSooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutSooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutSooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutSooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutSooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutSooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutSooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutSooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutSooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutSooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutSooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutSooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutSooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutSooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutSooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutSooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutSooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutSooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutSooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutSooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutSooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutSooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutSooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutSooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutSooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutSooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutSooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutSooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutSooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutSooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutSooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutSooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutSooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutSooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutSooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutSooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutSooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutSooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutSooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutSooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutSooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutSooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutSooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutSooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutSooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutSooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutSooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutSooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutSooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutSooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutSooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutSooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutSooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutSooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Split(src string) (string, string) {
+	_ret := _m.Called(src)
+
+	if _rf, ok := _ret.Get(0).(func(string) (string, string)); ok {
+		return _rf(src)
+	}
+
+	left := _ret.String(0)
+	right := _ret.String(1)
+
+	return left, right
+}
+
+func (_m *coconutMock) OnSplit(src string) *coconutSplitCall {
+	return &coconutSplitCall{Call: _m.Mock.On("Split", src), Parent: _m}
+}
+
+// OnSplitMatched is like OnSplit but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutSplitCall{Call: _m.Mock.On("Split", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return &coconutSplitCall{Call: _m.Mock.On("Split", src), Parent: _m}
+}
+
+type coconutSplitCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutSplitCall) Panic(msg string) *coconutSplitCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutSplitCall) Once() *coconutSplitCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutSplitCall) Twice() *coconutSplitCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutSplitCall) Times(i int) *coconutSplitCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutSplitCall) WaitUntil(w <-chan time.Time) *coconutSplitCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutSplitCall) After(d time.Duration) *coconutSplitCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutSplitCall) Run(fn func(args mock.Arguments)) *coconutSplitCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutSplitCall) Maybe() *coconutSplitCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutSplitCall) TypedReturns(a string, d string) *coconutSplitCall {
+	_c.Call = _c.Return(a, d)
+	return _c
+}
+
+func (_c *coconutSplitCall) ReturnsFn(fn func(string) (string, string)) *coconutSplitCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutSplitCall) TypedRun(fn func(string)) *coconutSplitCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_src := args.String(0)
+		fn(_src)
+	})
+	return _c
+}
+
+func (_c *coconutSplitCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutSplitCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutSplitCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutSplitCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutSplitCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutSplitCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutSplitCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutSplitCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutSplitCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutSplitCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutSplitCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutSplitCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutSplitCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutSplitCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutSplitCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutSplitCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutSplitCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutSplitCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutSplitCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutSplitCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutSplitCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutSplitCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutSplitCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutSplitCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutSplitCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutSplitCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutSplitCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutSplitCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutSplitCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutSplitCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutSplitCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutSplitCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutSplitCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutSplitCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutSplitCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutSplitCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutSplitCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutSplitCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutSplitCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutSplitCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutSplitCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutSplitCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutSplitCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutSplitCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutSplitCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutSplitCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutSplitCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutSplitCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutSplitCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutSplitCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutSplitCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutSplitCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutSplitCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutSplitCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutSplitCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutSplitCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutSplitCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutSplitCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutSplitCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutSplitCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutSplitCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutSplitCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutSplitCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutSplitCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Too(src string) time.Duration {
+	_ret := _m.Called(src)
+
+	if _rf, ok := _ret.Get(0).(func(string) time.Duration); ok {
+		return _rf(src)
+	}
+
+	_ra0, _ := _ret.Get(0).(time.Duration)
+
+	return _ra0
+}
+
+func (_m *coconutMock) OnToo(src string) *coconutTooCall {
+	return &coconutTooCall{Call: _m.Mock.On("Too", src), Parent: _m}
+}
+
+// OnTooMatched is like OnToo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnTooMatched(src func(string) bool) *coconutTooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutTooCall{Call: _m.Mock.On("Too", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnTooRaw(src interface{}) *coconutTooCall {
+	return &coconutTooCall{Call: _m.Mock.On("Too", src), Parent: _m}
+}
+
+type coconutTooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutTooCall) Panic(msg string) *coconutTooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutTooCall) Once() *coconutTooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutTooCall) Twice() *coconutTooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutTooCall) Times(i int) *coconutTooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutTooCall) WaitUntil(w <-chan time.Time) *coconutTooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutTooCall) After(d time.Duration) *coconutTooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutTooCall) Run(fn func(args mock.Arguments)) *coconutTooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutTooCall) Maybe() *coconutTooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutTooCall) TypedReturns(a time.Duration) *coconutTooCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *coconutTooCall) ReturnsFn(fn func(string) time.Duration) *coconutTooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutTooCall) TypedRun(fn func(string)) *coconutTooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_src := args.String(0)
+		fn(_src)
+	})
+	return _c
+}
+
+func (_c *coconutTooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutTooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutTooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutTooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutTooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutTooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutTooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutTooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutTooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutTooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutTooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutTooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutTooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutTooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutTooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutTooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutTooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutTooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutTooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutTooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutTooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutTooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutTooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutTooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutTooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutTooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutTooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutTooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutTooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutTooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutTooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutTooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutTooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutTooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutTooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutTooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutTooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutTooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutTooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutTooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutTooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutTooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutTooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutTooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutTooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutTooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutTooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutTooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutTooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutTooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutTooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutTooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutTooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutTooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutTooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutTooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutTooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutTooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutTooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Voo(src *module.Version) time.Duration {
+	_ret := _m.Called(src)
+
+	if _rf, ok := _ret.Get(0).(func(*module.Version) time.Duration); ok {
+		return _rf(src)
+	}
+
+	_ra0, _ := _ret.Get(0).(time.Duration)
+
+	return _ra0
+}
+
+func (_m *coconutMock) OnVoo(src *module.Version) *coconutVooCall {
+	return &coconutVooCall{Call: _m.Mock.On("Voo", src), Parent: _m}
+}
+
+// OnVooMatched is like OnVoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	_args := []interface{}{mock.Anything}
+
+	if src != nil {
+		_args[0] = mock.MatchedBy(src)
+	}
+
+	return &coconutVooCall{Call: _m.Mock.On("Voo", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnVooRaw(src interface{}) *coconutVooCall {
+	return &coconutVooCall{Call: _m.Mock.On("Voo", src), Parent: _m}
+}
+
+type coconutVooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutVooCall) Panic(msg string) *coconutVooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutVooCall) Once() *coconutVooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutVooCall) Twice() *coconutVooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutVooCall) Times(i int) *coconutVooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutVooCall) WaitUntil(w <-chan time.Time) *coconutVooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutVooCall) After(d time.Duration) *coconutVooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutVooCall) Run(fn func(args mock.Arguments)) *coconutVooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutVooCall) Maybe() *coconutVooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutVooCall) TypedReturns(a time.Duration) *coconutVooCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *coconutVooCall) ReturnsFn(fn func(*module.Version) time.Duration) *coconutVooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutVooCall) TypedRun(fn func(*module.Version)) *coconutVooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_src, _ := args.Get(0).(*module.Version)
+		fn(_src)
+	})
+	return _c
+}
+
+func (_c *coconutVooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutVooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutVooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutVooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutVooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutVooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutVooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutVooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutVooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutVooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutVooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutVooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutVooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutVooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutVooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutVooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutVooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutVooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutVooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutVooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutVooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutVooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutVooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutVooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutVooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutVooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutVooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutVooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutVooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutVooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutVooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutVooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutVooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutVooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutVooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutVooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutVooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutVooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutVooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutVooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutVooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutVooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutVooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutVooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutVooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutVooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutVooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutVooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutVooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutVooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutVooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutVooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutVooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutVooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutVooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutVooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutVooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutVooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutVooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Woo(fn func(string, ...int) string) string {
+	_ret := _m.Called(fn)
+
+	if _rf, ok := _ret.Get(0).(func(func(string, ...int) string) string); ok {
+		return _rf(fn)
+	}
+
+	_ra0 := _ret.String(0)
+
+	return _ra0
+}
+
+func (_m *coconutMock) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return &coconutWooCall{Call: _m.Mock.On("Woo", mock.Anything), Parent: _m}
+}
+
+// OnWooMatched is like OnWoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	_args := []interface{}{mock.Anything}
+
+	if fn != nil {
+		_args[0] = mock.MatchedBy(fn)
+	}
+
+	return &coconutWooCall{Call: _m.Mock.On("Woo", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnWooRaw(fn interface{}) *coconutWooCall {
+	return &coconutWooCall{Call: _m.Mock.On("Woo", mock.Anything), Parent: _m}
+}
+
+type coconutWooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutWooCall) Panic(msg string) *coconutWooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutWooCall) Once() *coconutWooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutWooCall) Twice() *coconutWooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutWooCall) Times(i int) *coconutWooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutWooCall) WaitUntil(w <-chan time.Time) *coconutWooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutWooCall) After(d time.Duration) *coconutWooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutWooCall) Run(fn func(args mock.Arguments)) *coconutWooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutWooCall) Maybe() *coconutWooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutWooCall) TypedReturns(a string) *coconutWooCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *coconutWooCall) ReturnsFn(fn func(func(string, ...int) string) string) *coconutWooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutWooCall) TypedRun(fn func(func(string, ...int) string)) *coconutWooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_fn, _ := args.Get(0).(func(string, ...int) string)
+		fn(_fn)
+	})
+	return _c
+}
+
+func (_c *coconutWooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutWooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutWooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutWooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutWooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutWooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutWooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutWooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutWooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutWooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutWooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutWooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutWooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutWooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutWooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutWooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutWooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutWooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutWooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutWooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutWooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutWooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutWooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutWooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutWooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutWooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutWooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutWooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutWooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutWooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutWooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutWooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutWooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutWooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutWooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutWooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutWooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutWooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutWooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutWooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutWooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutWooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutWooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutWooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutWooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutWooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutWooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutWooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutWooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutWooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutWooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutWooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutWooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutWooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutWooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutWooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutWooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutWooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutWooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutWooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutWooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutWooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutWooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutWooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Yoo(st string) any {
+	_ret := _m.Called(st)
+
+	if _rf, ok := _ret.Get(0).(func(string) any); ok {
+		return _rf(st)
+	}
+
+	_ra0, _ := _ret.Get(0).(any)
+
+	return _ra0
+}
+
+func (_m *coconutMock) OnYoo(st string) *coconutYooCall {
+	return &coconutYooCall{Call: _m.Mock.On("Yoo", st), Parent: _m}
+}
+
+// OnYooMatched is like OnYoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnYooMatched(st func(string) bool) *coconutYooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutYooCall{Call: _m.Mock.On("Yoo", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnYooRaw(st interface{}) *coconutYooCall {
+	return &coconutYooCall{Call: _m.Mock.On("Yoo", st), Parent: _m}
+}
+
+type coconutYooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutYooCall) Panic(msg string) *coconutYooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutYooCall) Once() *coconutYooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutYooCall) Twice() *coconutYooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutYooCall) Times(i int) *coconutYooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutYooCall) WaitUntil(w <-chan time.Time) *coconutYooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutYooCall) After(d time.Duration) *coconutYooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutYooCall) Run(fn func(args mock.Arguments)) *coconutYooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutYooCall) Maybe() *coconutYooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutYooCall) TypedReturns(a any) *coconutYooCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *coconutYooCall) ReturnsFn(fn func(string) any) *coconutYooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutYooCall) TypedRun(fn func(string)) *coconutYooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_st := args.String(0)
+		fn(_st)
+	})
+	return _c
+}
+
+func (_c *coconutYooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutYooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutYooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutYooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutYooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutYooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutYooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutYooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutYooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutYooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutYooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutYooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutYooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutYooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutYooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutYooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutYooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutYooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutYooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutYooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutYooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutYooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutYooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutYooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutYooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutYooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutYooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutYooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutYooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutYooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutYooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutYooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutYooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutYooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutYooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutYooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutYooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutYooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutYooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutYooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutYooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutYooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutYooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutYooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutYooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutYooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutYooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutYooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutYooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutYooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutYooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutYooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutYooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutYooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutYooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutYooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutYooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutYooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutYooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+func (_m *coconutMock) Zoo(st any) string {
+	_ret := _m.Called(st)
+
+	if _rf, ok := _ret.Get(0).(func(any) string); ok {
+		return _rf(st)
+	}
+
+	_ra0 := _ret.String(0)
+
+	return _ra0
+}
+
+func (_m *coconutMock) OnZoo(st any) *coconutZooCall {
+	return &coconutZooCall{Call: _m.Mock.On("Zoo", st), Parent: _m}
+}
+
+// OnZooMatched is like OnZoo but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *coconutMock) OnZooMatched(st func(any) bool) *coconutZooCall {
+	_args := []interface{}{mock.Anything}
+
+	if st != nil {
+		_args[0] = mock.MatchedBy(st)
+	}
+
+	return &coconutZooCall{Call: _m.Mock.On("Zoo", _args...), Parent: _m}
+}
+
+func (_m *coconutMock) OnZooRaw(st interface{}) *coconutZooCall {
+	return &coconutZooCall{Call: _m.Mock.On("Zoo", st), Parent: _m}
+}
+
+type coconutZooCall struct {
+	*mock.Call
+	Parent *coconutMock
+}
+
+func (_c *coconutZooCall) Panic(msg string) *coconutZooCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *coconutZooCall) Once() *coconutZooCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *coconutZooCall) Twice() *coconutZooCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *coconutZooCall) Times(i int) *coconutZooCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *coconutZooCall) WaitUntil(w <-chan time.Time) *coconutZooCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *coconutZooCall) After(d time.Duration) *coconutZooCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *coconutZooCall) Run(fn func(args mock.Arguments)) *coconutZooCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *coconutZooCall) Maybe() *coconutZooCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *coconutZooCall) TypedReturns(a string) *coconutZooCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *coconutZooCall) ReturnsFn(fn func(any) string) *coconutZooCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *coconutZooCall) TypedRun(fn func(any)) *coconutZooCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_st, _ := args.Get(0).(any)
+		fn(_st)
+	})
+	return _c
+}
+
+func (_c *coconutZooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
+	return _c.Parent.OnBoo(src)
+}
+
+func (_c *coconutZooCall) OnDoo(src time.Duration) *coconutDooCall {
+	return _c.Parent.OnDoo(src)
+}
+
+func (_c *coconutZooCall) OnFoo(st Strawberry) *coconutFooCall {
+	return _c.Parent.OnFoo(st)
+}
+
+func (_c *coconutZooCall) OnGoo(st string) *coconutGooCall {
+	return _c.Parent.OnGoo(st)
+}
+
+func (_c *coconutZooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
+	return _c.Parent.OnHoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
+	return _c.Parent.OnJoo(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnKoo(src string) *coconutKooCall {
+	return _c.Parent.OnKoo(src)
+}
+
+func (_c *coconutZooCall) OnLoo(st string, values []int) *coconutLooCall {
+	return _c.Parent.OnLoo(st, values...)
+}
+
+func (_c *coconutZooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
+	return _c.Parent.OnMoo(fn)
+}
+
+func (_c *coconutZooCall) OnNoo(ar [][2]string) *coconutNooCall {
+	return _c.Parent.OnNoo(ar)
+}
+
+func (_c *coconutZooCall) OnPair() *coconutPairCall {
+	return _c.Parent.OnPair()
+}
+
+func (_c *coconutZooCall) OnPoo(str struct{ name string }) *coconutPooCall {
+	return _c.Parent.OnPoo(str)
+}
+
+func (_c *coconutZooCall) OnQoo(a string, c_ int) *coconutQooCall {
+	return _c.Parent.OnQoo(a, c_)
+}
+
+func (_c *coconutZooCall) OnRet() *coconutRetCall {
+	return _c.Parent.OnRet()
+}
+
+func (_c *coconutZooCall) OnRoo(errs []error) *coconutRooCall {
+	return _c.Parent.OnRoo(errs)
+}
+
+func (_c *coconutZooCall) OnSoo(errs map[string]error) *coconutSooCall {
+	return _c.Parent.OnSoo(errs)
+}
+
+func (_c *coconutZooCall) OnSplit(src string) *coconutSplitCall {
+	return _c.Parent.OnSplit(src)
+}
+
+func (_c *coconutZooCall) OnToo(src string) *coconutTooCall {
+	return _c.Parent.OnToo(src)
+}
+
+func (_c *coconutZooCall) OnVoo(src *module.Version) *coconutVooCall {
+	return _c.Parent.OnVoo(src)
+}
+
+func (_c *coconutZooCall) OnWoo(fn func(string, ...int) string) *coconutWooCall {
+	return _c.Parent.OnWoo(fn)
+}
+
+func (_c *coconutZooCall) OnYoo(st string) *coconutYooCall {
+	return _c.Parent.OnYoo(st)
+}
+
+func (_c *coconutZooCall) OnZoo(st any) *coconutZooCall {
+	return _c.Parent.OnZoo(st)
+}
+
+func (_c *coconutZooCall) OnBooMatched(src func(*bytes.Buffer) bool) *coconutBooCall {
+	return _c.Parent.OnBooMatched(src)
+}
+
+func (_c *coconutZooCall) OnDooMatched(src func(time.Duration) bool) *coconutDooCall {
+	return _c.Parent.OnDooMatched(src)
+}
+
+func (_c *coconutZooCall) OnFooMatched(st func(Strawberry) bool) *coconutFooCall {
+	return _c.Parent.OnFooMatched(st)
+}
+
+func (_c *coconutZooCall) OnGooMatched(st func(string) bool) *coconutGooCall {
+	return _c.Parent.OnGooMatched(st)
+}
+
+func (_c *coconutZooCall) OnHooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutHooCall {
+	return _c.Parent.OnHooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnJooMatched(aParam func(string) bool, bParam func(int) bool, cParam func(Water) bool) *coconutJooCall {
+	return _c.Parent.OnJooMatched(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnKooMatched(src func(string) bool) *coconutKooCall {
+	return _c.Parent.OnKooMatched(src)
+}
+
+func (_c *coconutZooCall) OnLooMatched(st func(string) bool, values func([]int) bool) *coconutLooCall {
+	return _c.Parent.OnLooMatched(st, values)
+}
+
+func (_c *coconutZooCall) OnMooMatched(fn func(func(Strawberry, Strawberry) Pineapple) bool) *coconutMooCall {
+	return _c.Parent.OnMooMatched(fn)
+}
+
+func (_c *coconutZooCall) OnNooMatched(ar func([][2]string) bool) *coconutNooCall {
+	return _c.Parent.OnNooMatched(ar)
+}
+
+func (_c *coconutZooCall) OnPooMatched(str func(struct{ name string }) bool) *coconutPooCall {
+	return _c.Parent.OnPooMatched(str)
+}
+
+func (_c *coconutZooCall) OnQooMatched(a func(string) bool, c_ func(int) bool) *coconutQooCall {
+	return _c.Parent.OnQooMatched(a, c_)
+}
+
+func (_c *coconutZooCall) OnRooMatched(errs func([]error) bool) *coconutRooCall {
+	return _c.Parent.OnRooMatched(errs)
+}
+
+func (_c *coconutZooCall) OnSooMatched(errs func(map[string]error) bool) *coconutSooCall {
+	return _c.Parent.OnSooMatched(errs)
+}
+
+func (_c *coconutZooCall) OnSplitMatched(src func(string) bool) *coconutSplitCall {
+	return _c.Parent.OnSplitMatched(src)
+}
+
+func (_c *coconutZooCall) OnTooMatched(src func(string) bool) *coconutTooCall {
+	return _c.Parent.OnTooMatched(src)
+}
+
+func (_c *coconutZooCall) OnVooMatched(src func(*module.Version) bool) *coconutVooCall {
+	return _c.Parent.OnVooMatched(src)
+}
+
+func (_c *coconutZooCall) OnWooMatched(fn func(func(string, ...int) string) bool) *coconutWooCall {
+	return _c.Parent.OnWooMatched(fn)
+}
+
+func (_c *coconutZooCall) OnYooMatched(st func(string) bool) *coconutYooCall {
+	return _c.Parent.OnYooMatched(st)
+}
+
+func (_c *coconutZooCall) OnZooMatched(st func(any) bool) *coconutZooCall {
+	return _c.Parent.OnZooMatched(st)
+}
+
+func (_c *coconutZooCall) OnBooRaw(src interface{}) *coconutBooCall {
+	return _c.Parent.OnBooRaw(src)
+}
+
+func (_c *coconutZooCall) OnDooRaw(src interface{}) *coconutDooCall {
+	return _c.Parent.OnDooRaw(src)
+}
+
+func (_c *coconutZooCall) OnFooRaw(st interface{}) *coconutFooCall {
+	return _c.Parent.OnFooRaw(st)
+}
+
+func (_c *coconutZooCall) OnGooRaw(st interface{}) *coconutGooCall {
+	return _c.Parent.OnGooRaw(st)
+}
+
+func (_c *coconutZooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
+	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
+	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+}
+
+func (_c *coconutZooCall) OnKooRaw(src interface{}) *coconutKooCall {
+	return _c.Parent.OnKooRaw(src)
+}
+
+func (_c *coconutZooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
+	return _c.Parent.OnLooRaw(st, values)
+}
+
+func (_c *coconutZooCall) OnMooRaw(fn interface{}) *coconutMooCall {
+	return _c.Parent.OnMooRaw(fn)
+}
+
+func (_c *coconutZooCall) OnNooRaw(ar interface{}) *coconutNooCall {
+	return _c.Parent.OnNooRaw(ar)
+}
+
+func (_c *coconutZooCall) OnPairRaw() *coconutPairCall {
+	return _c.Parent.OnPairRaw()
+}
+
+func (_c *coconutZooCall) OnPooRaw(str interface{}) *coconutPooCall {
+	return _c.Parent.OnPooRaw(str)
+}
+
+func (_c *coconutZooCall) OnQooRaw(a interface{}, c_ interface{}) *coconutQooCall {
+	return _c.Parent.OnQooRaw(a, c_)
+}
+
+func (_c *coconutZooCall) OnRetRaw() *coconutRetCall {
+	return _c.Parent.OnRetRaw()
+}
+
+func (_c *coconutZooCall) OnRooRaw(errs interface{}) *coconutRooCall {
+	return _c.Parent.OnRooRaw(errs)
+}
+
+func (_c *coconutZooCall) OnSooRaw(errs interface{}) *coconutSooCall {
+	return _c.Parent.OnSooRaw(errs)
+}
+
+func (_c *coconutZooCall) OnSplitRaw(src interface{}) *coconutSplitCall {
+	return _c.Parent.OnSplitRaw(src)
+}
+
+func (_c *coconutZooCall) OnTooRaw(src interface{}) *coconutTooCall {
+	return _c.Parent.OnTooRaw(src)
+}
+
+func (_c *coconutZooCall) OnVooRaw(src interface{}) *coconutVooCall {
+	return _c.Parent.OnVooRaw(src)
+}
+
+func (_c *coconutZooCall) OnWooRaw(fn interface{}) *coconutWooCall {
+	return _c.Parent.OnWooRaw(fn)
+}
+
+func (_c *coconutZooCall) OnYooRaw(st interface{}) *coconutYooCall {
+	return _c.Parent.OnYooRaw(st)
+}
+
+func (_c *coconutZooCall) OnZooRaw(st interface{}) *coconutZooCall {
+	return _c.Parent.OnZooRaw(st)
+}
+
+// carrotMock is a mock of the Carrot interface (generated by mocktail).
+type carrotMock struct{ mock.Mock }
+
+// newCarrotMock creates a new carrotMock.
+func newCarrotMock(tb testing.TB) *carrotMock {
+	tb.Helper()
+
+	m := &carrotMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *carrotMock) Bar(aParam string) *b.Potato {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func(string) *b.Potato); ok {
+		return _rf(aParam)
+	}
+
+	_ra0, _ := _ret.Get(0).(*b.Potato)
+
+	return _ra0
+}
+
+func (_m *carrotMock) OnBar(aParam string) *carrotBarCall {
+	return &carrotBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
+}
+
+// OnBarMatched is like OnBar but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *carrotMock) OnBarMatched(aParam func(string) bool) *carrotBarCall {
+	_args := []interface{}{mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	return &carrotBarCall{Call: _m.Mock.On("Bar", _args...), Parent: _m}
+}
+
+func (_m *carrotMock) OnBarRaw(aParam interface{}) *carrotBarCall {
+	return &carrotBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
+}
+
+type carrotBarCall struct {
+	*mock.Call
+	Parent *carrotMock
+}
+
+func (_c *carrotBarCall) Panic(msg string) *carrotBarCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *carrotBarCall) Once() *carrotBarCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *carrotBarCall) Twice() *carrotBarCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *carrotBarCall) Times(i int) *carrotBarCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *carrotBarCall) WaitUntil(w <-chan time.Time) *carrotBarCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *carrotBarCall) After(d time.Duration) *carrotBarCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *carrotBarCall) Run(fn func(args mock.Arguments)) *carrotBarCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *carrotBarCall) Maybe() *carrotBarCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *carrotBarCall) TypedReturns(a *b.Potato) *carrotBarCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *carrotBarCall) ReturnsFn(fn func(string) *b.Potato) *carrotBarCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *carrotBarCall) TypedRun(fn func(string)) *carrotBarCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam := args.String(0)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *carrotBarCall) OnBar(aParam string) *carrotBarCall {
+	return _c.Parent.OnBar(aParam)
+}
+
+func (_c *carrotBarCall) OnBur(aParam string) *carrotBurCall {
+	return _c.Parent.OnBur(aParam)
+}
+
+func (_c *carrotBarCall) OnBarMatched(aParam func(string) bool) *carrotBarCall {
+	return _c.Parent.OnBarMatched(aParam)
+}
+
+func (_c *carrotBarCall) OnBurMatched(aParam func(string) bool) *carrotBurCall {
+	return _c.Parent.OnBurMatched(aParam)
+}
+
+func (_c *carrotBarCall) OnBarRaw(aParam interface{}) *carrotBarCall {
+	return _c.Parent.OnBarRaw(aParam)
+}
+
+func (_c *carrotBarCall) OnBurRaw(aParam interface{}) *carrotBurCall {
+	return _c.Parent.OnBurRaw(aParam)
+}
+
+func (_m *carrotMock) Bur(aParam string) *c.Cherry {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func(string) *c.Cherry); ok {
+		return _rf(aParam)
+	}
+
+	_ra0, _ := _ret.Get(0).(*c.Cherry)
+
+	return _ra0
+}
+
+func (_m *carrotMock) OnBur(aParam string) *carrotBurCall {
+	return &carrotBurCall{Call: _m.Mock.On("Bur", aParam), Parent: _m}
+}
+
+// OnBurMatched is like OnBur but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *carrotMock) OnBurMatched(aParam func(string) bool) *carrotBurCall {
+	_args := []interface{}{mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	return &carrotBurCall{Call: _m.Mock.On("Bur", _args...), Parent: _m}
+}
+
+func (_m *carrotMock) OnBurRaw(aParam interface{}) *carrotBurCall {
+	return &carrotBurCall{Call: _m.Mock.On("Bur", aParam), Parent: _m}
+}
+
+type carrotBurCall struct {
+	*mock.Call
+	Parent *carrotMock
+}
+
+func (_c *carrotBurCall) Panic(msg string) *carrotBurCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *carrotBurCall) Once() *carrotBurCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *carrotBurCall) Twice() *carrotBurCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *carrotBurCall) Times(i int) *carrotBurCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *carrotBurCall) WaitUntil(w <-chan time.Time) *carrotBurCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *carrotBurCall) After(d time.Duration) *carrotBurCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *carrotBurCall) Run(fn func(args mock.Arguments)) *carrotBurCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *carrotBurCall) Maybe() *carrotBurCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *carrotBurCall) TypedReturns(a *c.Cherry) *carrotBurCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *carrotBurCall) ReturnsFn(fn func(string) *c.Cherry) *carrotBurCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *carrotBurCall) TypedRun(fn func(string)) *carrotBurCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam := args.String(0)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *carrotBurCall) OnBar(aParam string) *carrotBarCall {
+	return _c.Parent.OnBar(aParam)
+}
+
+func (_c *carrotBurCall) OnBur(aParam string) *carrotBurCall {
+	return _c.Parent.OnBur(aParam)
+}
+
+func (_c *carrotBurCall) OnBarMatched(aParam func(string) bool) *carrotBarCall {
+	return _c.Parent.OnBarMatched(aParam)
+}
+
+func (_c *carrotBurCall) OnBurMatched(aParam func(string) bool) *carrotBurCall {
+	return _c.Parent.OnBurMatched(aParam)
+}
+
+func (_c *carrotBurCall) OnBarRaw(aParam interface{}) *carrotBarCall {
+	return _c.Parent.OnBarRaw(aParam)
+}
+
+func (_c *carrotBurCall) OnBurRaw(aParam interface{}) *carrotBurCall {
+	return _c.Parent.OnBurRaw(aParam)
+}
+
+// orangeMock is a mock of the Orange interface (generated by mocktail).
+type orangeMock struct{ mock.Mock }
+
+// newOrangeMock creates a new orangeMock.
+func newOrangeMock(tb testing.TB) *orangeMock {
+	tb.Helper()
+
+	m := &orangeMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *orangeMock) Juice() <-chan struct{} {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() <-chan struct{}); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(<-chan struct{})
+
+	return _ra0
+}
+
+func (_m *orangeMock) OnJuice() *orangeJuiceCall {
+	return &orangeJuiceCall{Call: _m.Mock.On("Juice"), Parent: _m}
+}
+
+func (_m *orangeMock) OnJuiceRaw() *orangeJuiceCall {
+	return &orangeJuiceCall{Call: _m.Mock.On("Juice"), Parent: _m}
+}
+
+type orangeJuiceCall struct {
+	*mock.Call
+	Parent *orangeMock
+}
+
+func (_c *orangeJuiceCall) Panic(msg string) *orangeJuiceCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *orangeJuiceCall) Once() *orangeJuiceCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *orangeJuiceCall) Twice() *orangeJuiceCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *orangeJuiceCall) Times(i int) *orangeJuiceCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *orangeJuiceCall) WaitUntil(w <-chan time.Time) *orangeJuiceCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *orangeJuiceCall) After(d time.Duration) *orangeJuiceCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *orangeJuiceCall) Run(fn func(args mock.Arguments)) *orangeJuiceCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *orangeJuiceCall) Maybe() *orangeJuiceCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *orangeJuiceCall) TypedReturns(a <-chan struct{}) *orangeJuiceCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *orangeJuiceCall) ReturnsFn(fn func() <-chan struct{}) *orangeJuiceCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *orangeJuiceCall) TypedRun(fn func()) *orangeJuiceCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *orangeJuiceCall) OnJuice() *orangeJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *orangeJuiceCall) OnJuiceRaw() *orangeJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+// cherryMock is a mock of the Cherry interface (generated by mocktail).
+type cherryMock struct{ mock.Mock }
+
+// newCherryMock creates a new cherryMock.
+func newCherryMock(tb testing.TB) *cherryMock {
+	tb.Helper()
+
+	m := &cherryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *cherryMock) V2Carrot() e.V2Carrot {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() e.V2Carrot); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(e.V2Carrot)
+
+	return _ra0
+}
+
+func (_m *cherryMock) OnV2Carrot() *cherryV2CarrotCall {
+	return &cherryV2CarrotCall{Call: _m.Mock.On("V2Carrot"), Parent: _m}
+}
+
+func (_m *cherryMock) OnV2CarrotRaw() *cherryV2CarrotCall {
+	return &cherryV2CarrotCall{Call: _m.Mock.On("V2Carrot"), Parent: _m}
+}
+
+type cherryV2CarrotCall struct {
+	*mock.Call
+	Parent *cherryMock
+}
+
+func (_c *cherryV2CarrotCall) Panic(msg string) *cherryV2CarrotCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) Once() *cherryV2CarrotCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) Twice() *cherryV2CarrotCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) Times(i int) *cherryV2CarrotCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) WaitUntil(w <-chan time.Time) *cherryV2CarrotCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) After(d time.Duration) *cherryV2CarrotCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) Run(fn func(args mock.Arguments)) *cherryV2CarrotCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) Maybe() *cherryV2CarrotCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) TypedReturns(a e.V2Carrot) *cherryV2CarrotCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) ReturnsFn(fn func() e.V2Carrot) *cherryV2CarrotCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) TypedRun(fn func()) *cherryV2CarrotCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *cherryV2CarrotCall) OnV2Carrot() *cherryV2CarrotCall {
+	return _c.Parent.OnV2Carrot()
+}
+
+func (_c *cherryV2CarrotCall) OnV2CarrotRaw() *cherryV2CarrotCall {
+	return _c.Parent.OnV2CarrotRaw()
+}
+
+// bananaMock is a mock of the Banana interface (generated by mocktail).
+type bananaMock[T any, U any] struct{ mock.Mock }
+
+// newBananaMock creates a new bananaMock.
+func newBananaMock[T any, U any](tb testing.TB) *bananaMock[T, U] {
+	tb.Helper()
+
+	m := &bananaMock[T, U]{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *bananaMock[T, U]) Flower() U {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() U); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(U)
+
+	return _ra0
+}
+
+func (_m *bananaMock[T, U]) OnFlower() *bananaFlowerCall[T, U] {
+	return &bananaFlowerCall[T, U]{Call: _m.Mock.On("Flower"), Parent: _m}
+}
+
+func (_m *bananaMock[T, U]) OnFlowerRaw() *bananaFlowerCall[T, U] {
+	return &bananaFlowerCall[T, U]{Call: _m.Mock.On("Flower"), Parent: _m}
+}
+
+type bananaFlowerCall[T any, U any] struct {
+	*mock.Call
+	Parent *bananaMock[T, U]
+}
+
+func (_c *bananaFlowerCall[T, U]) Panic(msg string) *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) Once() *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) Twice() *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) Times(i int) *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) WaitUntil(w <-chan time.Time) *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) After(d time.Duration) *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) Run(fn func(args mock.Arguments)) *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) Maybe() *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) TypedReturns(a U) *bananaFlowerCall[T, U] {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) ReturnsFn(fn func() U) *bananaFlowerCall[T, U] {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) TypedRun(fn func()) *bananaFlowerCall[T, U] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *bananaFlowerCall[T, U]) OnFlower() *bananaFlowerCall[T, U] {
+	return _c.Parent.OnFlower()
+}
+
+func (_c *bananaFlowerCall[T, U]) OnPudding() *bananaPuddingCall[T, U] {
+	return _c.Parent.OnPudding()
+}
+
+func (_c *bananaFlowerCall[T, U]) OnTree(aParam T) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTree(aParam)
+}
+
+func (_c *bananaFlowerCall[T, U]) OnTreeMatched(aParam func(T) bool) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTreeMatched(aParam)
+}
+
+func (_c *bananaFlowerCall[T, U]) OnFlowerRaw() *bananaFlowerCall[T, U] {
+	return _c.Parent.OnFlowerRaw()
+}
+
+func (_c *bananaFlowerCall[T, U]) OnPuddingRaw() *bananaPuddingCall[T, U] {
+	return _c.Parent.OnPuddingRaw()
+}
+
+func (_c *bananaFlowerCall[T, U]) OnTreeRaw(aParam interface{}) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTreeRaw(aParam)
+}
+
+func (_m *bananaMock[T, U]) Pudding() {
+	_m.Called()
+}
+
+func (_m *bananaMock[T, U]) OnPudding() *bananaPuddingCall[T, U] {
+	return &bananaPuddingCall[T, U]{Call: _m.Mock.On("Pudding"), Parent: _m}
+}
+
+func (_m *bananaMock[T, U]) OnPuddingRaw() *bananaPuddingCall[T, U] {
+	return &bananaPuddingCall[T, U]{Call: _m.Mock.On("Pudding"), Parent: _m}
+}
+
+type bananaPuddingCall[T any, U any] struct {
+	*mock.Call
+	Parent *bananaMock[T, U]
+}
+
+func (_c *bananaPuddingCall[T, U]) Panic(msg string) *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) Once() *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) Twice() *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) Times(i int) *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) WaitUntil(w <-chan time.Time) *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) After(d time.Duration) *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) Run(fn func(args mock.Arguments)) *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) Maybe() *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) TypedRun(fn func()) *bananaPuddingCall[T, U] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *bananaPuddingCall[T, U]) OnFlower() *bananaFlowerCall[T, U] {
+	return _c.Parent.OnFlower()
+}
+
+func (_c *bananaPuddingCall[T, U]) OnPudding() *bananaPuddingCall[T, U] {
+	return _c.Parent.OnPudding()
+}
+
+func (_c *bananaPuddingCall[T, U]) OnTree(aParam T) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTree(aParam)
+}
+
+func (_c *bananaPuddingCall[T, U]) OnTreeMatched(aParam func(T) bool) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTreeMatched(aParam)
+}
+
+func (_c *bananaPuddingCall[T, U]) OnFlowerRaw() *bananaFlowerCall[T, U] {
+	return _c.Parent.OnFlowerRaw()
+}
+
+func (_c *bananaPuddingCall[T, U]) OnPuddingRaw() *bananaPuddingCall[T, U] {
+	return _c.Parent.OnPuddingRaw()
+}
+
+func (_c *bananaPuddingCall[T, U]) OnTreeRaw(aParam interface{}) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTreeRaw(aParam)
+}
+
+func (_m *bananaMock[T, U]) Tree(aParam T) {
+	_m.Called(aParam)
+}
+
+func (_m *bananaMock[T, U]) OnTree(aParam T) *bananaTreeCall[T, U] {
+	return &bananaTreeCall[T, U]{Call: _m.Mock.On("Tree", aParam), Parent: _m}
+}
+
+// OnTreeMatched is like OnTree but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *bananaMock[T, U]) OnTreeMatched(aParam func(T) bool) *bananaTreeCall[T, U] {
+	_args := []interface{}{mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	return &bananaTreeCall[T, U]{Call: _m.Mock.On("Tree", _args...), Parent: _m}
+}
+
+func (_m *bananaMock[T, U]) OnTreeRaw(aParam interface{}) *bananaTreeCall[T, U] {
+	return &bananaTreeCall[T, U]{Call: _m.Mock.On("Tree", aParam), Parent: _m}
+}
+
+type bananaTreeCall[T any, U any] struct {
+	*mock.Call
+	Parent *bananaMock[T, U]
+}
+
+func (_c *bananaTreeCall[T, U]) Panic(msg string) *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) Once() *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) Twice() *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) Times(i int) *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) WaitUntil(w <-chan time.Time) *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) After(d time.Duration) *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) Run(fn func(args mock.Arguments)) *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) Maybe() *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) TypedRun(fn func(T)) *bananaTreeCall[T, U] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam, _ := args.Get(0).(T)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *bananaTreeCall[T, U]) OnFlower() *bananaFlowerCall[T, U] {
+	return _c.Parent.OnFlower()
+}
+
+func (_c *bananaTreeCall[T, U]) OnPudding() *bananaPuddingCall[T, U] {
+	return _c.Parent.OnPudding()
+}
+
+func (_c *bananaTreeCall[T, U]) OnTree(aParam T) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTree(aParam)
+}
+
+func (_c *bananaTreeCall[T, U]) OnTreeMatched(aParam func(T) bool) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTreeMatched(aParam)
+}
+
+func (_c *bananaTreeCall[T, U]) OnFlowerRaw() *bananaFlowerCall[T, U] {
+	return _c.Parent.OnFlowerRaw()
+}
+
+func (_c *bananaTreeCall[T, U]) OnPuddingRaw() *bananaPuddingCall[T, U] {
+	return _c.Parent.OnPuddingRaw()
+}
+
+func (_c *bananaTreeCall[T, U]) OnTreeRaw(aParam interface{}) *bananaTreeCall[T, U] {
+	return _c.Parent.OnTreeRaw(aParam)
+}
+
+// cacheMock is a mock of the Cache interface (generated by mocktail).
+type cacheMock[K comparable, V any] struct{ mock.Mock }
+
+// newCacheMock creates a new cacheMock.
+func newCacheMock[K comparable, V any](tb testing.TB) *cacheMock[K, V] {
+	tb.Helper()
+
+	m := &cacheMock[K, V]{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *cacheMock[K, V]) Get(k K) (V, bool) {
+	_ret := _m.Called(k)
+
+	if _rf, ok := _ret.Get(0).(func(K) (V, bool)); ok {
+		return _rf(k)
+	}
+
+	_ra0, _ := _ret.Get(0).(V)
+	_rb1 := _ret.Bool(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *cacheMock[K, V]) OnGet(k K) *cacheGetCall[K, V] {
+	return &cacheGetCall[K, V]{Call: _m.Mock.On("Get", k), Parent: _m}
+}
+
+// OnGetMatched is like OnGet but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *cacheMock[K, V]) OnGetMatched(k func(K) bool) *cacheGetCall[K, V] {
+	_args := []interface{}{mock.Anything}
+
+	if k != nil {
+		_args[0] = mock.MatchedBy(k)
+	}
+
+	return &cacheGetCall[K, V]{Call: _m.Mock.On("Get", _args...), Parent: _m}
+}
+
+func (_m *cacheMock[K, V]) OnGetRaw(k interface{}) *cacheGetCall[K, V] {
+	return &cacheGetCall[K, V]{Call: _m.Mock.On("Get", k), Parent: _m}
+}
+
+type cacheGetCall[K comparable, V any] struct {
+	*mock.Call
+	Parent *cacheMock[K, V]
+}
+
+func (_c *cacheGetCall[K, V]) Panic(msg string) *cacheGetCall[K, V] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) Once() *cacheGetCall[K, V] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) Twice() *cacheGetCall[K, V] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) Times(i int) *cacheGetCall[K, V] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) WaitUntil(w <-chan time.Time) *cacheGetCall[K, V] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) After(d time.Duration) *cacheGetCall[K, V] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) Run(fn func(args mock.Arguments)) *cacheGetCall[K, V] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) Maybe() *cacheGetCall[K, V] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) TypedReturns(a V, d bool) *cacheGetCall[K, V] {
+	_c.Call = _c.Return(a, d)
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) ReturnsFn(fn func(K) (V, bool)) *cacheGetCall[K, V] {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) TypedRun(fn func(K)) *cacheGetCall[K, V] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_k, _ := args.Get(0).(K)
+		fn(_k)
+	})
+	return _c
+}
+
+func (_c *cacheGetCall[K, V]) OnGet(k K) *cacheGetCall[K, V] {
+	return _c.Parent.OnGet(k)
+}
+
+func (_c *cacheGetCall[K, V]) OnSet(k K, v V) *cacheSetCall[K, V] {
+	return _c.Parent.OnSet(k, v)
+}
+
+func (_c *cacheGetCall[K, V]) OnGetMatched(k func(K) bool) *cacheGetCall[K, V] {
+	return _c.Parent.OnGetMatched(k)
+}
+
+func (_c *cacheGetCall[K, V]) OnSetMatched(k func(K) bool, v func(V) bool) *cacheSetCall[K, V] {
+	return _c.Parent.OnSetMatched(k, v)
+}
+
+func (_c *cacheGetCall[K, V]) OnGetRaw(k interface{}) *cacheGetCall[K, V] {
+	return _c.Parent.OnGetRaw(k)
+}
+
+func (_c *cacheGetCall[K, V]) OnSetRaw(k interface{}, v interface{}) *cacheSetCall[K, V] {
+	return _c.Parent.OnSetRaw(k, v)
+}
+
+func (_m *cacheMock[K, V]) Set(k K, v V) {
+	_m.Called(k, v)
+}
+
+func (_m *cacheMock[K, V]) OnSet(k K, v V) *cacheSetCall[K, V] {
+	return &cacheSetCall[K, V]{Call: _m.Mock.On("Set", k, v), Parent: _m}
+}
+
+// OnSetMatched is like OnSet but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *cacheMock[K, V]) OnSetMatched(k func(K) bool, v func(V) bool) *cacheSetCall[K, V] {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if k != nil {
+		_args[0] = mock.MatchedBy(k)
+	}
+
+	if v != nil {
+		_args[1] = mock.MatchedBy(v)
+	}
+
+	return &cacheSetCall[K, V]{Call: _m.Mock.On("Set", _args...), Parent: _m}
+}
+
+func (_m *cacheMock[K, V]) OnSetRaw(k interface{}, v interface{}) *cacheSetCall[K, V] {
+	return &cacheSetCall[K, V]{Call: _m.Mock.On("Set", k, v), Parent: _m}
+}
+
+type cacheSetCall[K comparable, V any] struct {
+	*mock.Call
+	Parent *cacheMock[K, V]
+}
+
+func (_c *cacheSetCall[K, V]) Panic(msg string) *cacheSetCall[K, V] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) Once() *cacheSetCall[K, V] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) Twice() *cacheSetCall[K, V] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) Times(i int) *cacheSetCall[K, V] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) WaitUntil(w <-chan time.Time) *cacheSetCall[K, V] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) After(d time.Duration) *cacheSetCall[K, V] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) Run(fn func(args mock.Arguments)) *cacheSetCall[K, V] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) Maybe() *cacheSetCall[K, V] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) TypedRun(fn func(K, V)) *cacheSetCall[K, V] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_k, _ := args.Get(0).(K)
+		_v, _ := args.Get(1).(V)
+		fn(_k, _v)
+	})
+	return _c
+}
+
+func (_c *cacheSetCall[K, V]) OnGet(k K) *cacheGetCall[K, V] {
+	return _c.Parent.OnGet(k)
+}
+
+func (_c *cacheSetCall[K, V]) OnSet(k K, v V) *cacheSetCall[K, V] {
+	return _c.Parent.OnSet(k, v)
+}
+
+func (_c *cacheSetCall[K, V]) OnGetMatched(k func(K) bool) *cacheGetCall[K, V] {
+	return _c.Parent.OnGetMatched(k)
+}
+
+func (_c *cacheSetCall[K, V]) OnSetMatched(k func(K) bool, v func(V) bool) *cacheSetCall[K, V] {
+	return _c.Parent.OnSetMatched(k, v)
+}
+
+func (_c *cacheSetCall[K, V]) OnGetRaw(k interface{}) *cacheGetCall[K, V] {
+	return _c.Parent.OnGetRaw(k)
+}
+
+func (_c *cacheSetCall[K, V]) OnSetRaw(k interface{}, v interface{}) *cacheSetCall[K, V] {
+	return _c.Parent.OnSetRaw(k, v)
+}
+
+// kitchenMock is a mock of the Kitchen interface (generated by mocktail).
+type kitchenMock struct{ mock.Mock }
+
+// newKitchenMock creates a new kitchenMock.
+func newKitchenMock(tb testing.TB) *kitchenMock {
+	tb.Helper()
+
+	m := &kitchenMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *kitchenMock) Bar(aParam string) *b.Potato {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func(string) *b.Potato); ok {
+		return _rf(aParam)
+	}
+
+	_ra0, _ := _ret.Get(0).(*b.Potato)
+
+	return _ra0
+}
+
+func (_m *kitchenMock) OnBar(aParam string) *kitchenBarCall {
+	return &kitchenBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
+}
+
+// OnBarMatched is like OnBar but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *kitchenMock) OnBarMatched(aParam func(string) bool) *kitchenBarCall {
+	_args := []interface{}{mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	return &kitchenBarCall{Call: _m.Mock.On("Bar", _args...), Parent: _m}
+}
+
+func (_m *kitchenMock) OnBarRaw(aParam interface{}) *kitchenBarCall {
+	return &kitchenBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
+}
+
+type kitchenBarCall struct {
+	*mock.Call
+	Parent *kitchenMock
+}
+
+func (_c *kitchenBarCall) Panic(msg string) *kitchenBarCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *kitchenBarCall) Once() *kitchenBarCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *kitchenBarCall) Twice() *kitchenBarCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *kitchenBarCall) Times(i int) *kitchenBarCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *kitchenBarCall) WaitUntil(w <-chan time.Time) *kitchenBarCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *kitchenBarCall) After(d time.Duration) *kitchenBarCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *kitchenBarCall) Run(fn func(args mock.Arguments)) *kitchenBarCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *kitchenBarCall) Maybe() *kitchenBarCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *kitchenBarCall) TypedReturns(a *b.Potato) *kitchenBarCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *kitchenBarCall) ReturnsFn(fn func(string) *b.Potato) *kitchenBarCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *kitchenBarCall) TypedRun(fn func(string)) *kitchenBarCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam := args.String(0)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *kitchenBarCall) OnBar(aParam string) *kitchenBarCall {
+	return _c.Parent.OnBar(aParam)
+}
+
+func (_c *kitchenBarCall) OnBur(aParam string) *kitchenBurCall {
+	return _c.Parent.OnBur(aParam)
+}
+
+func (_c *kitchenBarCall) OnCook(p *b.Potato) *kitchenCookCall {
+	return _c.Parent.OnCook(p)
+}
+
+func (_c *kitchenBarCall) OnGarnish(cherry b.Cherry) *kitchenGarnishCall {
+	return _c.Parent.OnGarnish(cherry)
+}
+
+func (_c *kitchenBarCall) OnBarMatched(aParam func(string) bool) *kitchenBarCall {
+	return _c.Parent.OnBarMatched(aParam)
+}
+
+func (_c *kitchenBarCall) OnBurMatched(aParam func(string) bool) *kitchenBurCall {
+	return _c.Parent.OnBurMatched(aParam)
+}
+
+func (_c *kitchenBarCall) OnCookMatched(p func(*b.Potato) bool) *kitchenCookCall {
+	return _c.Parent.OnCookMatched(p)
+}
+
+func (_c *kitchenBarCall) OnGarnishMatched(cherry func(b.Cherry) bool) *kitchenGarnishCall {
+	return _c.Parent.OnGarnishMatched(cherry)
+}
+
+func (_c *kitchenBarCall) OnBarRaw(aParam interface{}) *kitchenBarCall {
+	return _c.Parent.OnBarRaw(aParam)
+}
+
+func (_c *kitchenBarCall) OnBurRaw(aParam interface{}) *kitchenBurCall {
+	return _c.Parent.OnBurRaw(aParam)
+}
+
+func (_c *kitchenBarCall) OnCookRaw(p interface{}) *kitchenCookCall {
+	return _c.Parent.OnCookRaw(p)
+}
+
+func (_c *kitchenBarCall) OnGarnishRaw(cherry interface{}) *kitchenGarnishCall {
+	return _c.Parent.OnGarnishRaw(cherry)
+}
+
+func (_m *kitchenMock) Bur(aParam string) *c.Cherry {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func(string) *c.Cherry); ok {
+		return _rf(aParam)
+	}
+
+	_ra0, _ := _ret.Get(0).(*c.Cherry)
+
+	return _ra0
+}
+
+func (_m *kitchenMock) OnBur(aParam string) *kitchenBurCall {
+	return &kitchenBurCall{Call: _m.Mock.On("Bur", aParam), Parent: _m}
+}
+
+// OnBurMatched is like OnBur but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *kitchenMock) OnBurMatched(aParam func(string) bool) *kitchenBurCall {
+	_args := []interface{}{mock.Anything}
+
+	if aParam != nil {
+		_args[0] = mock.MatchedBy(aParam)
+	}
+
+	return &kitchenBurCall{Call: _m.Mock.On("Bur", _args...), Parent: _m}
+}
+
+func (_m *kitchenMock) OnBurRaw(aParam interface{}) *kitchenBurCall {
+	return &kitchenBurCall{Call: _m.Mock.On("Bur", aParam), Parent: _m}
+}
+
+type kitchenBurCall struct {
+	*mock.Call
+	Parent *kitchenMock
+}
+
+func (_c *kitchenBurCall) Panic(msg string) *kitchenBurCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *kitchenBurCall) Once() *kitchenBurCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *kitchenBurCall) Twice() *kitchenBurCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *kitchenBurCall) Times(i int) *kitchenBurCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *kitchenBurCall) WaitUntil(w <-chan time.Time) *kitchenBurCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *kitchenBurCall) After(d time.Duration) *kitchenBurCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *kitchenBurCall) Run(fn func(args mock.Arguments)) *kitchenBurCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *kitchenBurCall) Maybe() *kitchenBurCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *kitchenBurCall) TypedReturns(a *c.Cherry) *kitchenBurCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *kitchenBurCall) ReturnsFn(fn func(string) *c.Cherry) *kitchenBurCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *kitchenBurCall) TypedRun(fn func(string)) *kitchenBurCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam := args.String(0)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *kitchenBurCall) OnBar(aParam string) *kitchenBarCall {
+	return _c.Parent.OnBar(aParam)
+}
+
+func (_c *kitchenBurCall) OnBur(aParam string) *kitchenBurCall {
+	return _c.Parent.OnBur(aParam)
+}
+
+func (_c *kitchenBurCall) OnCook(p *b.Potato) *kitchenCookCall {
+	return _c.Parent.OnCook(p)
+}
+
+func (_c *kitchenBurCall) OnGarnish(cherry b.Cherry) *kitchenGarnishCall {
+	return _c.Parent.OnGarnish(cherry)
+}
+
+func (_c *kitchenBurCall) OnBarMatched(aParam func(string) bool) *kitchenBarCall {
+	return _c.Parent.OnBarMatched(aParam)
+}
+
+func (_c *kitchenBurCall) OnBurMatched(aParam func(string) bool) *kitchenBurCall {
+	return _c.Parent.OnBurMatched(aParam)
+}
+
+func (_c *kitchenBurCall) OnCookMatched(p func(*b.Potato) bool) *kitchenCookCall {
+	return _c.Parent.OnCookMatched(p)
+}
+
+func (_c *kitchenBurCall) OnGarnishMatched(cherry func(b.Cherry) bool) *kitchenGarnishCall {
+	return _c.Parent.OnGarnishMatched(cherry)
+}
+
+func (_c *kitchenBurCall) OnBarRaw(aParam interface{}) *kitchenBarCall {
+	return _c.Parent.OnBarRaw(aParam)
+}
+
+func (_c *kitchenBurCall) OnBurRaw(aParam interface{}) *kitchenBurCall {
+	return _c.Parent.OnBurRaw(aParam)
+}
+
+func (_c *kitchenBurCall) OnCookRaw(p interface{}) *kitchenCookCall {
+	return _c.Parent.OnCookRaw(p)
+}
+
+func (_c *kitchenBurCall) OnGarnishRaw(cherry interface{}) *kitchenGarnishCall {
+	return _c.Parent.OnGarnishRaw(cherry)
+}
+
+func (_m *kitchenMock) Cook(p *b.Potato) error {
+	_ret := _m.Called(p)
+
+	if _rf, ok := _ret.Get(0).(func(*b.Potato) error); ok {
+		return _rf(p)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *kitchenMock) OnCook(p *b.Potato) *kitchenCookCall {
+	return &kitchenCookCall{Call: _m.Mock.On("Cook", p), Parent: _m}
+}
+
+// OnCookMatched is like OnCook but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *kitchenMock) OnCookMatched(p func(*b.Potato) bool) *kitchenCookCall {
+	_args := []interface{}{mock.Anything}
+
+	if p != nil {
+		_args[0] = mock.MatchedBy(p)
+	}
+
+	return &kitchenCookCall{Call: _m.Mock.On("Cook", _args...), Parent: _m}
+}
+
+func (_m *kitchenMock) OnCookRaw(p interface{}) *kitchenCookCall {
+	return &kitchenCookCall{Call: _m.Mock.On("Cook", p), Parent: _m}
+}
+
+type kitchenCookCall struct {
+	*mock.Call
+	Parent *kitchenMock
+}
+
+func (_c *kitchenCookCall) Panic(msg string) *kitchenCookCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *kitchenCookCall) Once() *kitchenCookCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *kitchenCookCall) Twice() *kitchenCookCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *kitchenCookCall) Times(i int) *kitchenCookCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *kitchenCookCall) WaitUntil(w <-chan time.Time) *kitchenCookCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *kitchenCookCall) After(d time.Duration) *kitchenCookCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *kitchenCookCall) Run(fn func(args mock.Arguments)) *kitchenCookCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *kitchenCookCall) Maybe() *kitchenCookCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *kitchenCookCall) TypedReturns(a error) *kitchenCookCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *kitchenCookCall) ReturnsFn(fn func(*b.Potato) error) *kitchenCookCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *kitchenCookCall) TypedRun(fn func(*b.Potato)) *kitchenCookCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_p, _ := args.Get(0).(*b.Potato)
+		fn(_p)
+	})
+	return _c
+}
+
+func (_c *kitchenCookCall) OnBar(aParam string) *kitchenBarCall {
+	return _c.Parent.OnBar(aParam)
+}
+
+func (_c *kitchenCookCall) OnBur(aParam string) *kitchenBurCall {
+	return _c.Parent.OnBur(aParam)
+}
+
+func (_c *kitchenCookCall) OnCook(p *b.Potato) *kitchenCookCall {
+	return _c.Parent.OnCook(p)
+}
+
+func (_c *kitchenCookCall) OnGarnish(cherry b.Cherry) *kitchenGarnishCall {
+	return _c.Parent.OnGarnish(cherry)
+}
+
+func (_c *kitchenCookCall) OnBarMatched(aParam func(string) bool) *kitchenBarCall {
+	return _c.Parent.OnBarMatched(aParam)
+}
+
+func (_c *kitchenCookCall) OnBurMatched(aParam func(string) bool) *kitchenBurCall {
+	return _c.Parent.OnBurMatched(aParam)
+}
+
+func (_c *kitchenCookCall) OnCookMatched(p func(*b.Potato) bool) *kitchenCookCall {
+	return _c.Parent.OnCookMatched(p)
+}
+
+func (_c *kitchenCookCall) OnGarnishMatched(cherry func(b.Cherry) bool) *kitchenGarnishCall {
+	return _c.Parent.OnGarnishMatched(cherry)
+}
+
+func (_c *kitchenCookCall) OnBarRaw(aParam interface{}) *kitchenBarCall {
+	return _c.Parent.OnBarRaw(aParam)
+}
+
+func (_c *kitchenCookCall) OnBurRaw(aParam interface{}) *kitchenBurCall {
+	return _c.Parent.OnBurRaw(aParam)
+}
+
+func (_c *kitchenCookCall) OnCookRaw(p interface{}) *kitchenCookCall {
+	return _c.Parent.OnCookRaw(p)
+}
+
+func (_c *kitchenCookCall) OnGarnishRaw(cherry interface{}) *kitchenGarnishCall {
+	return _c.Parent.OnGarnishRaw(cherry)
+}
+
+func (_m *kitchenMock) Garnish(cherry b.Cherry) error {
+	_ret := _m.Called(cherry)
+
+	if _rf, ok := _ret.Get(0).(func(b.Cherry) error); ok {
+		return _rf(cherry)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *kitchenMock) OnGarnish(cherry b.Cherry) *kitchenGarnishCall {
+	return &kitchenGarnishCall{Call: _m.Mock.On("Garnish", cherry), Parent: _m}
+}
+
+// OnGarnishMatched is like OnGarnish but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *kitchenMock) OnGarnishMatched(cherry func(b.Cherry) bool) *kitchenGarnishCall {
+	_args := []interface{}{mock.Anything}
+
+	if cherry != nil {
+		_args[0] = mock.MatchedBy(cherry)
+	}
+
+	return &kitchenGarnishCall{Call: _m.Mock.On("Garnish", _args...), Parent: _m}
+}
+
+func (_m *kitchenMock) OnGarnishRaw(cherry interface{}) *kitchenGarnishCall {
+	return &kitchenGarnishCall{Call: _m.Mock.On("Garnish", cherry), Parent: _m}
+}
+
+type kitchenGarnishCall struct {
+	*mock.Call
+	Parent *kitchenMock
+}
+
+func (_c *kitchenGarnishCall) Panic(msg string) *kitchenGarnishCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *kitchenGarnishCall) Once() *kitchenGarnishCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *kitchenGarnishCall) Twice() *kitchenGarnishCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *kitchenGarnishCall) Times(i int) *kitchenGarnishCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *kitchenGarnishCall) WaitUntil(w <-chan time.Time) *kitchenGarnishCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *kitchenGarnishCall) After(d time.Duration) *kitchenGarnishCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *kitchenGarnishCall) Run(fn func(args mock.Arguments)) *kitchenGarnishCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *kitchenGarnishCall) Maybe() *kitchenGarnishCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *kitchenGarnishCall) TypedReturns(a error) *kitchenGarnishCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *kitchenGarnishCall) ReturnsFn(fn func(b.Cherry) error) *kitchenGarnishCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *kitchenGarnishCall) TypedRun(fn func(b.Cherry)) *kitchenGarnishCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_cherry, _ := args.Get(0).(b.Cherry)
+		fn(_cherry)
+	})
+	return _c
+}
+
+func (_c *kitchenGarnishCall) OnBar(aParam string) *kitchenBarCall {
+	return _c.Parent.OnBar(aParam)
+}
+
+func (_c *kitchenGarnishCall) OnBur(aParam string) *kitchenBurCall {
+	return _c.Parent.OnBur(aParam)
+}
+
+func (_c *kitchenGarnishCall) OnCook(p *b.Potato) *kitchenCookCall {
+	return _c.Parent.OnCook(p)
+}
+
+func (_c *kitchenGarnishCall) OnGarnish(cherry b.Cherry) *kitchenGarnishCall {
+	return _c.Parent.OnGarnish(cherry)
+}
+
+func (_c *kitchenGarnishCall) OnBarMatched(aParam func(string) bool) *kitchenBarCall {
+	return _c.Parent.OnBarMatched(aParam)
+}
+
+func (_c *kitchenGarnishCall) OnBurMatched(aParam func(string) bool) *kitchenBurCall {
+	return _c.Parent.OnBurMatched(aParam)
+}
+
+func (_c *kitchenGarnishCall) OnCookMatched(p func(*b.Potato) bool) *kitchenCookCall {
+	return _c.Parent.OnCookMatched(p)
+}
+
+func (_c *kitchenGarnishCall) OnGarnishMatched(cherry func(b.Cherry) bool) *kitchenGarnishCall {
+	return _c.Parent.OnGarnishMatched(cherry)
+}
+
+func (_c *kitchenGarnishCall) OnBarRaw(aParam interface{}) *kitchenBarCall {
+	return _c.Parent.OnBarRaw(aParam)
+}
+
+func (_c *kitchenGarnishCall) OnBurRaw(aParam interface{}) *kitchenBurCall {
+	return _c.Parent.OnBurRaw(aParam)
+}
+
+func (_c *kitchenGarnishCall) OnCookRaw(p interface{}) *kitchenCookCall {
+	return _c.Parent.OnCookRaw(p)
+}
+
+func (_c *kitchenGarnishCall) OnGarnishRaw(cherry interface{}) *kitchenGarnishCall {
+	return _c.Parent.OnGarnishRaw(cherry)
+}
+
+// databaseMock is a mock of the Database interface (generated by mocktail).
+type databaseMock struct{ mock.Mock }
+
+// newDatabaseMock creates a new databaseMock.
+func newDatabaseMock(tb testing.TB) *databaseMock {
+	tb.Helper()
+
+	m := &databaseMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *databaseMock) Exec(query string) (sql2.Result, error) {
+	_ret := _m.Called(query)
+
+	if _rf, ok := _ret.Get(0).(func(string) (sql2.Result, error)); ok {
+		return _rf(query)
+	}
+
+	_ra0, _ := _ret.Get(0).(sql2.Result)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *databaseMock) OnExec(query string) *databaseExecCall {
+	return &databaseExecCall{Call: _m.Mock.On("Exec", query), Parent: _m}
+}
+
+// OnExecMatched is like OnExec but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *databaseMock) OnExecMatched(query func(string) bool) *databaseExecCall {
+	_args := []interface{}{mock.Anything}
+
+	if query != nil {
+		_args[0] = mock.MatchedBy(query)
+	}
+
+	return &databaseExecCall{Call: _m.Mock.On("Exec", _args...), Parent: _m}
+}
+
+func (_m *databaseMock) OnExecRaw(query interface{}) *databaseExecCall {
+	return &databaseExecCall{Call: _m.Mock.On("Exec", query), Parent: _m}
+}
+
+type databaseExecCall struct {
+	*mock.Call
+	Parent *databaseMock
+}
+
+func (_c *databaseExecCall) Panic(msg string) *databaseExecCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *databaseExecCall) Once() *databaseExecCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *databaseExecCall) Twice() *databaseExecCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *databaseExecCall) Times(i int) *databaseExecCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *databaseExecCall) WaitUntil(w <-chan time.Time) *databaseExecCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *databaseExecCall) After(d time.Duration) *databaseExecCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *databaseExecCall) Run(fn func(args mock.Arguments)) *databaseExecCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *databaseExecCall) Maybe() *databaseExecCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *databaseExecCall) TypedReturns(a sql2.Result, d error) *databaseExecCall {
+	_c.Call = _c.Return(a, d)
+	return _c
+}
+
+func (_c *databaseExecCall) ReturnsFn(fn func(string) (sql2.Result, error)) *databaseExecCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+// TypedReturnsError is like TypedReturns but returns the zero values with the error.
+func (_c *databaseExecCall) TypedReturnsError(err error) *databaseExecCall {
+	var a sql2.Result
+
+	_c.Call = _c.Return(a, err)
+	return _c
+}
+
+func (_c *databaseExecCall) TypedRun(fn func(string)) *databaseExecCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_query := args.String(0)
+		fn(_query)
+	})
+	return _c
+}
+
+func (_c *databaseExecCall) OnExec(query string) *databaseExecCall {
+	return _c.Parent.OnExec(query)
+}
+
+func (_c *databaseExecCall) OnLast() *databaseLastCall {
+	return _c.Parent.OnLast()
+}
+
+func (_c *databaseExecCall) OnExecMatched(query func(string) bool) *databaseExecCall {
+	return _c.Parent.OnExecMatched(query)
+}
+
+func (_c *databaseExecCall) OnExecRaw(query interface{}) *databaseExecCall {
+	return _c.Parent.OnExecRaw(query)
+}
+
+func (_c *databaseExecCall) OnLastRaw() *databaseLastCall {
+	return _c.Parent.OnLastRaw()
+}
+
+func (_m *databaseMock) Last() sql.Result {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() sql.Result); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(sql.Result)
+
+	return _ra0
+}
+
+func (_m *databaseMock) OnLast() *databaseLastCall {
+	return &databaseLastCall{Call: _m.Mock.On("Last"), Parent: _m}
+}
+
+func (_m *databaseMock) OnLastRaw() *databaseLastCall {
+	return &databaseLastCall{Call: _m.Mock.On("Last"), Parent: _m}
+}
+
+type databaseLastCall struct {
+	*mock.Call
+	Parent *databaseMock
+}
+
+func (_c *databaseLastCall) Panic(msg string) *databaseLastCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *databaseLastCall) Once() *databaseLastCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *databaseLastCall) Twice() *databaseLastCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *databaseLastCall) Times(i int) *databaseLastCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *databaseLastCall) WaitUntil(w <-chan time.Time) *databaseLastCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *databaseLastCall) After(d time.Duration) *databaseLastCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *databaseLastCall) Run(fn func(args mock.Arguments)) *databaseLastCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *databaseLastCall) Maybe() *databaseLastCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *databaseLastCall) TypedReturns(a sql.Result) *databaseLastCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *databaseLastCall) ReturnsFn(fn func() sql.Result) *databaseLastCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *databaseLastCall) TypedRun(fn func()) *databaseLastCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *databaseLastCall) OnExec(query string) *databaseExecCall {
+	return _c.Parent.OnExec(query)
+}
+
+func (_c *databaseLastCall) OnLast() *databaseLastCall {
+	return _c.Parent.OnLast()
+}
+
+func (_c *databaseLastCall) OnExecMatched(query func(string) bool) *databaseExecCall {
+	return _c.Parent.OnExecMatched(query)
+}
+
+func (_c *databaseLastCall) OnExecRaw(query interface{}) *databaseExecCall {
+	return _c.Parent.OnExecRaw(query)
+}
+
+func (_c *databaseLastCall) OnLastRaw() *databaseLastCall {
+	return _c.Parent.OnLastRaw()
+}
+
+// libraryMock is a mock of the Library interface (generated by mocktail).
+type libraryMock struct{ mock.Mock }
+
+// newLibraryMock creates a new libraryMock.
+func newLibraryMock(tb testing.TB) *libraryMock {
+	tb.Helper()
+
+	m := &libraryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *libraryMock) Get(p *Page[g.User]) *Page[g.User] {
+	_ret := _m.Called(p)
+
+	if _rf, ok := _ret.Get(0).(func(*Page[g.User]) *Page[g.User]); ok {
+		return _rf(p)
+	}
+
+	_ra0, _ := _ret.Get(0).(*Page[g.User])
+
+	return _ra0
+}
+
+func (_m *libraryMock) OnGet(p *Page[g.User]) *libraryGetCall {
+	return &libraryGetCall{Call: _m.Mock.On("Get", p), Parent: _m}
+}
+
+// OnGetMatched is like OnGet but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *libraryMock) OnGetMatched(p func(*Page[g.User]) bool) *libraryGetCall {
+	_args := []interface{}{mock.Anything}
+
+	if p != nil {
+		_args[0] = mock.MatchedBy(p)
+	}
+
+	return &libraryGetCall{Call: _m.Mock.On("Get", _args...), Parent: _m}
+}
+
+func (_m *libraryMock) OnGetRaw(p interface{}) *libraryGetCall {
+	return &libraryGetCall{Call: _m.Mock.On("Get", p), Parent: _m}
+}
+
+type libraryGetCall struct {
+	*mock.Call
+	Parent *libraryMock
+}
+
+func (_c *libraryGetCall) Panic(msg string) *libraryGetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *libraryGetCall) Once() *libraryGetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *libraryGetCall) Twice() *libraryGetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *libraryGetCall) Times(i int) *libraryGetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *libraryGetCall) WaitUntil(w <-chan time.Time) *libraryGetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *libraryGetCall) After(d time.Duration) *libraryGetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *libraryGetCall) Run(fn func(args mock.Arguments)) *libraryGetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *libraryGetCall) Maybe() *libraryGetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *libraryGetCall) TypedReturns(a *Page[g.User]) *libraryGetCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *libraryGetCall) ReturnsFn(fn func(*Page[g.User]) *Page[g.User]) *libraryGetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *libraryGetCall) TypedRun(fn func(*Page[g.User])) *libraryGetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_p, _ := args.Get(0).(*Page[g.User])
+		fn(_p)
+	})
+	return _c
+}
+
+func (_c *libraryGetCall) OnGet(p *Page[g.User]) *libraryGetCall {
+	return _c.Parent.OnGet(p)
+}
+
+func (_c *libraryGetCall) OnIndex(pages map[string]*Page[g.User]) *libraryIndexCall {
+	return _c.Parent.OnIndex(pages)
+}
+
+func (_c *libraryGetCall) OnList(pages []Page[g.User]) *libraryListCall {
+	return _c.Parent.OnList(pages)
+}
+
+func (_c *libraryGetCall) OnGetMatched(p func(*Page[g.User]) bool) *libraryGetCall {
+	return _c.Parent.OnGetMatched(p)
+}
+
+func (_c *libraryGetCall) OnIndexMatched(pages func(map[string]*Page[g.User]) bool) *libraryIndexCall {
+	return _c.Parent.OnIndexMatched(pages)
+}
+
+func (_c *libraryGetCall) OnListMatched(pages func([]Page[g.User]) bool) *libraryListCall {
+	return _c.Parent.OnListMatched(pages)
+}
+
+func (_c *libraryGetCall) OnGetRaw(p interface{}) *libraryGetCall {
+	return _c.Parent.OnGetRaw(p)
+}
+
+func (_c *libraryGetCall) OnIndexRaw(pages interface{}) *libraryIndexCall {
+	return _c.Parent.OnIndexRaw(pages)
+}
+
+func (_c *libraryGetCall) OnListRaw(pages interface{}) *libraryListCall {
+	return _c.Parent.OnListRaw(pages)
+}
+
+func (_m *libraryMock) Index(pages map[string]*Page[g.User]) map[string]*Page[g.User] {
+	_ret := _m.Called(pages)
+
+	if _rf, ok := _ret.Get(0).(func(map[string]*Page[g.User]) map[string]*Page[g.User]); ok {
+		return _rf(pages)
+	}
+
+	_ra0, _ := _ret.Get(0).(map[string]*Page[g.User])
+
+	return _ra0
+}
+
+func (_m *libraryMock) OnIndex(pages map[string]*Page[g.User]) *libraryIndexCall {
+	return &libraryIndexCall{Call: _m.Mock.On("Index", pages), Parent: _m}
+}
+
+// OnIndexMatched is like OnIndex but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *libraryMock) OnIndexMatched(pages func(map[string]*Page[g.User]) bool) *libraryIndexCall {
+	_args := []interface{}{mock.Anything}
+
+	if pages != nil {
+		_args[0] = mock.MatchedBy(pages)
+	}
+
+	return &libraryIndexCall{Call: _m.Mock.On("Index", _args...), Parent: _m}
+}
+
+func (_m *libraryMock) OnIndexRaw(pages interface{}) *libraryIndexCall {
+	return &libraryIndexCall{Call: _m.Mock.On("Index", pages), Parent: _m}
+}
+
+type libraryIndexCall struct {
+	*mock.Call
+	Parent *libraryMock
+}
+
+func (_c *libraryIndexCall) Panic(msg string) *libraryIndexCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *libraryIndexCall) Once() *libraryIndexCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *libraryIndexCall) Twice() *libraryIndexCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *libraryIndexCall) Times(i int) *libraryIndexCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *libraryIndexCall) WaitUntil(w <-chan time.Time) *libraryIndexCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *libraryIndexCall) After(d time.Duration) *libraryIndexCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *libraryIndexCall) Run(fn func(args mock.Arguments)) *libraryIndexCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *libraryIndexCall) Maybe() *libraryIndexCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *libraryIndexCall) TypedReturns(a map[string]*Page[g.User]) *libraryIndexCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *libraryIndexCall) ReturnsFn(fn func(map[string]*Page[g.User]) map[string]*Page[g.User]) *libraryIndexCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *libraryIndexCall) TypedRun(fn func(map[string]*Page[g.User])) *libraryIndexCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_pages, _ := args.Get(0).(map[string]*Page[g.User])
+		fn(_pages)
+	})
+	return _c
+}
+
+func (_c *libraryIndexCall) OnGet(p *Page[g.User]) *libraryGetCall {
+	return _c.Parent.OnGet(p)
+}
+
+func (_c *libraryIndexCall) OnIndex(pages map[string]*Page[g.User]) *libraryIndexCall {
+	return _c.Parent.OnIndex(pages)
+}
+
+func (_c *libraryIndexCall) OnList(pages []Page[g.User]) *libraryListCall {
+	return _c.Parent.OnList(pages)
+}
+
+func (_c *libraryIndexCall) OnGetMatched(p func(*Page[g.User]) bool) *libraryGetCall {
+	return _c.Parent.OnGetMatched(p)
+}
+
+func (_c *libraryIndexCall) OnIndexMatched(pages func(map[string]*Page[g.User]) bool) *libraryIndexCall {
+	return _c.Parent.OnIndexMatched(pages)
+}
+
+func (_c *libraryIndexCall) OnListMatched(pages func([]Page[g.User]) bool) *libraryListCall {
+	return _c.Parent.OnListMatched(pages)
+}
+
+func (_c *libraryIndexCall) OnGetRaw(p interface{}) *libraryGetCall {
+	return _c.Parent.OnGetRaw(p)
+}
+
+func (_c *libraryIndexCall) OnIndexRaw(pages interface{}) *libraryIndexCall {
+	return _c.Parent.OnIndexRaw(pages)
+}
+
+func (_c *libraryIndexCall) OnListRaw(pages interface{}) *libraryListCall {
+	return _c.Parent.OnListRaw(pages)
+}
+
+func (_m *libraryMock) List(pages []Page[g.User]) []Page[g.User] {
+	_ret := _m.Called(pages)
+
+	if _rf, ok := _ret.Get(0).(func([]Page[g.User]) []Page[g.User]); ok {
+		return _rf(pages)
+	}
+
+	_ra0, _ := _ret.Get(0).([]Page[g.User])
+
+	return _ra0
+}
+
+func (_m *libraryMock) OnList(pages []Page[g.User]) *libraryListCall {
+	return &libraryListCall{Call: _m.Mock.On("List", pages), Parent: _m}
+}
+
+// OnListMatched is like OnList but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *libraryMock) OnListMatched(pages func([]Page[g.User]) bool) *libraryListCall {
+	_args := []interface{}{mock.Anything}
+
+	if pages != nil {
+		_args[0] = mock.MatchedBy(pages)
+	}
+
+	return &libraryListCall{Call: _m.Mock.On("List", _args...), Parent: _m}
+}
+
+func (_m *libraryMock) OnListRaw(pages interface{}) *libraryListCall {
+	return &libraryListCall{Call: _m.Mock.On("List", pages), Parent: _m}
+}
+
+type libraryListCall struct {
+	*mock.Call
+	Parent *libraryMock
+}
+
+func (_c *libraryListCall) Panic(msg string) *libraryListCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *libraryListCall) Once() *libraryListCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *libraryListCall) Twice() *libraryListCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *libraryListCall) Times(i int) *libraryListCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *libraryListCall) WaitUntil(w <-chan time.Time) *libraryListCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *libraryListCall) After(d time.Duration) *libraryListCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *libraryListCall) Run(fn func(args mock.Arguments)) *libraryListCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *libraryListCall) Maybe() *libraryListCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *libraryListCall) TypedReturns(a []Page[g.User]) *libraryListCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *libraryListCall) ReturnsFn(fn func([]Page[g.User]) []Page[g.User]) *libraryListCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *libraryListCall) TypedRun(fn func([]Page[g.User])) *libraryListCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_pages, _ := args.Get(0).([]Page[g.User])
+		fn(_pages)
+	})
+	return _c
+}
+
+func (_c *libraryListCall) OnGet(p *Page[g.User]) *libraryGetCall {
+	return _c.Parent.OnGet(p)
+}
+
+func (_c *libraryListCall) OnIndex(pages map[string]*Page[g.User]) *libraryIndexCall {
+	return _c.Parent.OnIndex(pages)
+}
+
+func (_c *libraryListCall) OnList(pages []Page[g.User]) *libraryListCall {
+	return _c.Parent.OnList(pages)
+}
+
+func (_c *libraryListCall) OnGetMatched(p func(*Page[g.User]) bool) *libraryGetCall {
+	return _c.Parent.OnGetMatched(p)
+}
+
+func (_c *libraryListCall) OnIndexMatched(pages func(map[string]*Page[g.User]) bool) *libraryIndexCall {
+	return _c.Parent.OnIndexMatched(pages)
+}
+
+func (_c *libraryListCall) OnListMatched(pages func([]Page[g.User]) bool) *libraryListCall {
+	return _c.Parent.OnListMatched(pages)
+}
+
+func (_c *libraryListCall) OnGetRaw(p interface{}) *libraryGetCall {
+	return _c.Parent.OnGetRaw(p)
+}
+
+func (_c *libraryListCall) OnIndexRaw(pages interface{}) *libraryIndexCall {
+	return _c.Parent.OnIndexRaw(pages)
+}
+
+func (_c *libraryListCall) OnListRaw(pages interface{}) *libraryListCall {
+	return _c.Parent.OnListRaw(pages)
+}
+
+// applierMock is a mock of the Applier interface (generated by mocktail).
+type applierMock struct{ mock.Mock }
+
+// newApplierMock creates a new applierMock.
+func newApplierMock(tb testing.TB) *applierMock {
+	tb.Helper()
+
+	m := &applierMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *applierMock) Apply(changes []Change, opts ...Option) {
+	_m.Called(changes, opts)
+}
+
+func (_m *applierMock) OnApply(changes []Change, opts ...Option) *applierApplyCall {
+	return &applierApplyCall{Call: _m.Mock.On("Apply", changes, opts), Parent: _m}
+}
+
+// OnApplyMatched is like OnApply but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *applierMock) OnApplyMatched(changes func([]Change) bool, opts func([]Option) bool) *applierApplyCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if changes != nil {
+		_args[0] = mock.MatchedBy(changes)
+	}
+
+	if opts != nil {
+		_args[1] = mock.MatchedBy(opts)
+	}
+
+	return &applierApplyCall{Call: _m.Mock.On("Apply", _args...), Parent: _m}
+}
+
+func (_m *applierMock) OnApplyRaw(changes interface{}, opts interface{}) *applierApplyCall {
+	return &applierApplyCall{Call: _m.Mock.On("Apply", changes, opts), Parent: _m}
+}
+
+type applierApplyCall struct {
+	*mock.Call
+	Parent *applierMock
+}
+
+func (_c *applierApplyCall) Panic(msg string) *applierApplyCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *applierApplyCall) Once() *applierApplyCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *applierApplyCall) Twice() *applierApplyCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *applierApplyCall) Times(i int) *applierApplyCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *applierApplyCall) WaitUntil(w <-chan time.Time) *applierApplyCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *applierApplyCall) After(d time.Duration) *applierApplyCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *applierApplyCall) Run(fn func(args mock.Arguments)) *applierApplyCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *applierApplyCall) Maybe() *applierApplyCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *applierApplyCall) TypedRun(fn func([]Change, ...Option)) *applierApplyCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_changes, _ := args.Get(0).([]Change)
+		_opts, _ := args.Get(1).([]Option)
+		fn(_changes, _opts...)
+	})
+	return _c
+}
+
+func (_c *applierApplyCall) OnApply(changes []Change, opts ...Option) *applierApplyCall {
+	return _c.Parent.OnApply(changes, opts...)
+}
+
+func (_c *applierApplyCall) OnApplyMatched(changes func([]Change) bool, opts func([]Option) bool) *applierApplyCall {
+	return _c.Parent.OnApplyMatched(changes, opts)
+}
+
+func (_c *applierApplyCall) OnApplyRaw(changes interface{}, opts interface{}) *applierApplyCall {
+	return _c.Parent.OnApplyRaw(changes, opts)
+}
+
+// lemonMock is a mock of the Lemon interface (generated by mocktail).
+type lemonMock struct{ mock.Mock }
+
+// newLemonMock creates a new lemonMock.
+func newLemonMock(tb testing.TB) *lemonMock {
+	tb.Helper()
+
+	m := &lemonMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *lemonMock) Address() unsafe.Pointer {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() unsafe.Pointer); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(unsafe.Pointer)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnAddress() *lemonAddressCall {
+	return &lemonAddressCall{Call: _m.Mock.On("Address"), Parent: _m}
+}
+
+func (_m *lemonMock) OnAddressRaw() *lemonAddressCall {
+	return &lemonAddressCall{Call: _m.Mock.On("Address"), Parent: _m}
+}
+
+type lemonAddressCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonAddressCall) Panic(msg string) *lemonAddressCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonAddressCall) Once() *lemonAddressCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonAddressCall) Twice() *lemonAddressCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonAddressCall) Times(i int) *lemonAddressCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonAddressCall) WaitUntil(w <-chan time.Time) *lemonAddressCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonAddressCall) After(d time.Duration) *lemonAddressCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonAddressCall) Run(fn func(args mock.Arguments)) *lemonAddressCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonAddressCall) Maybe() *lemonAddressCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonAddressCall) TypedReturns(a unsafe.Pointer) *lemonAddressCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonAddressCall) ReturnsFn(fn func() unsafe.Pointer) *lemonAddressCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonAddressCall) TypedRun(fn func()) *lemonAddressCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *lemonAddressCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonAddressCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonAddressCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonAddressCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonAddressCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonAddressCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonAddressCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonAddressCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonAddressCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonAddressCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonAddressCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonAddressCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonAddressCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonAddressCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonAddressCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonAddressCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonAddressCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonAddressCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonAddressCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonAddressCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonAddressCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonAddressCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonAddressCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonAddressCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonAddressCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonAddressCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Juice() any {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() any); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(any)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnJuice() *lemonJuiceCall {
+	return &lemonJuiceCall{Call: _m.Mock.On("Juice"), Parent: _m}
+}
+
+func (_m *lemonMock) OnJuiceRaw() *lemonJuiceCall {
+	return &lemonJuiceCall{Call: _m.Mock.On("Juice"), Parent: _m}
+}
+
+type lemonJuiceCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonJuiceCall) Panic(msg string) *lemonJuiceCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonJuiceCall) Once() *lemonJuiceCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonJuiceCall) Twice() *lemonJuiceCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonJuiceCall) Times(i int) *lemonJuiceCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonJuiceCall) WaitUntil(w <-chan time.Time) *lemonJuiceCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonJuiceCall) After(d time.Duration) *lemonJuiceCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonJuiceCall) Run(fn func(args mock.Arguments)) *lemonJuiceCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonJuiceCall) Maybe() *lemonJuiceCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonJuiceCall) TypedReturns(a any) *lemonJuiceCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonJuiceCall) ReturnsFn(fn func() any) *lemonJuiceCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonJuiceCall) TypedRun(fn func()) *lemonJuiceCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *lemonJuiceCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonJuiceCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonJuiceCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonJuiceCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonJuiceCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonJuiceCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonJuiceCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonJuiceCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonJuiceCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonJuiceCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonJuiceCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonJuiceCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonJuiceCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonJuiceCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonJuiceCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonJuiceCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonJuiceCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonJuiceCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonJuiceCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonJuiceCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonJuiceCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonJuiceCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonJuiceCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonJuiceCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonJuiceCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonJuiceCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Peel() func() io.Reader {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() func() io.Reader); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(func() io.Reader)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnPeel() *lemonPeelCall {
+	return &lemonPeelCall{Call: _m.Mock.On("Peel"), Parent: _m}
+}
+
+func (_m *lemonMock) OnPeelRaw() *lemonPeelCall {
+	return &lemonPeelCall{Call: _m.Mock.On("Peel"), Parent: _m}
+}
+
+type lemonPeelCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonPeelCall) Panic(msg string) *lemonPeelCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonPeelCall) Once() *lemonPeelCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonPeelCall) Twice() *lemonPeelCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonPeelCall) Times(i int) *lemonPeelCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonPeelCall) WaitUntil(w <-chan time.Time) *lemonPeelCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonPeelCall) After(d time.Duration) *lemonPeelCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonPeelCall) Run(fn func(args mock.Arguments)) *lemonPeelCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonPeelCall) Maybe() *lemonPeelCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonPeelCall) TypedReturns(a func() io.Reader) *lemonPeelCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonPeelCall) ReturnsFn(fn func() func() io.Reader) *lemonPeelCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonPeelCall) TypedRun(fn func()) *lemonPeelCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *lemonPeelCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonPeelCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonPeelCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonPeelCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonPeelCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonPeelCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonPeelCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonPeelCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonPeelCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonPeelCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonPeelCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonPeelCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonPeelCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonPeelCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonPeelCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonPeelCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonPeelCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonPeelCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonPeelCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonPeelCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonPeelCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonPeelCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonPeelCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonPeelCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonPeelCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonPeelCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Pick(time_ time.Time, module_ module.Version) io.Reader {
+	_ret := _m.Called(time_, module_)
+
+	if _rf, ok := _ret.Get(0).(func(time.Time, module.Version) io.Reader); ok {
+		return _rf(time_, module_)
+	}
+
+	_ra0, _ := _ret.Get(0).(io.Reader)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return &lemonPickCall{Call: _m.Mock.On("Pick", time_, module_), Parent: _m}
+}
+
+// OnPickMatched is like OnPick but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *lemonMock) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if time_ != nil {
+		_args[0] = mock.MatchedBy(time_)
+	}
+
+	if module_ != nil {
+		_args[1] = mock.MatchedBy(module_)
+	}
+
+	return &lemonPickCall{Call: _m.Mock.On("Pick", _args...), Parent: _m}
+}
+
+func (_m *lemonMock) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return &lemonPickCall{Call: _m.Mock.On("Pick", time_, module_), Parent: _m}
+}
+
+type lemonPickCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonPickCall) Panic(msg string) *lemonPickCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonPickCall) Once() *lemonPickCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonPickCall) Twice() *lemonPickCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonPickCall) Times(i int) *lemonPickCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonPickCall) WaitUntil(w <-chan time.Time) *lemonPickCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonPickCall) After(d time.Duration) *lemonPickCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonPickCall) Run(fn func(args mock.Arguments)) *lemonPickCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonPickCall) Maybe() *lemonPickCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonPickCall) TypedReturns(a io.Reader) *lemonPickCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonPickCall) ReturnsFn(fn func(time.Time, module.Version) io.Reader) *lemonPickCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonPickCall) TypedRun(fn func(time.Time, module.Version)) *lemonPickCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_time_, _ := args.Get(0).(time.Time)
+		_module_, _ := args.Get(1).(module.Version)
+		fn(_time_, _module_)
+	})
+	return _c
+}
+
+func (_c *lemonPickCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonPickCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonPickCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonPickCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonPickCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonPickCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonPickCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonPickCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonPickCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonPickCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonPickCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonPickCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonPickCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonPickCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonPickCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonPickCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonPickCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonPickCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonPickCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonPickCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonPickCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonPickCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonPickCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonPickCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonPickCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonPickCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Pour(handlers map[string]func(context.Context) error) error {
+	_ret := _m.Called(handlers)
+
+	if _rf, ok := _ret.Get(0).(func(map[string]func(context.Context) error) error); ok {
+		return _rf(handlers)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return &lemonPourCall{Call: _m.Mock.On("Pour", handlers), Parent: _m}
+}
+
+// OnPourMatched is like OnPour but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *lemonMock) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	_args := []interface{}{mock.Anything}
+
+	if handlers != nil {
+		_args[0] = mock.MatchedBy(handlers)
+	}
+
+	return &lemonPourCall{Call: _m.Mock.On("Pour", _args...), Parent: _m}
+}
+
+func (_m *lemonMock) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return &lemonPourCall{Call: _m.Mock.On("Pour", handlers), Parent: _m}
+}
+
+type lemonPourCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonPourCall) Panic(msg string) *lemonPourCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonPourCall) Once() *lemonPourCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonPourCall) Twice() *lemonPourCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonPourCall) Times(i int) *lemonPourCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonPourCall) WaitUntil(w <-chan time.Time) *lemonPourCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonPourCall) After(d time.Duration) *lemonPourCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonPourCall) Run(fn func(args mock.Arguments)) *lemonPourCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonPourCall) Maybe() *lemonPourCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonPourCall) TypedReturns(a error) *lemonPourCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonPourCall) ReturnsFn(fn func(map[string]func(context.Context) error) error) *lemonPourCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonPourCall) TypedRun(fn func(map[string]func(context.Context) error)) *lemonPourCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_handlers, _ := args.Get(0).(map[string]func(context.Context) error)
+		fn(_handlers)
+	})
+	return _c
+}
+
+func (_c *lemonPourCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonPourCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonPourCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonPourCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonPourCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonPourCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonPourCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonPourCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonPourCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonPourCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonPourCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonPourCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonPourCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonPourCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonPourCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonPourCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonPourCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonPourCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonPourCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonPourCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonPourCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonPourCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonPourCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonPourCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonPourCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonPourCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Press() interface {
+	io.Closer
+	Origin() module.Version
+	Read([]byte) (int, error)
+} {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() interface {
+		io.Closer
+		Origin() module.Version
+		Read([]byte) (int, error)
+	}); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(interface {
+		io.Closer
+		Origin() module.Version
+		Read([]byte) (int, error)
+	})
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnPress() *lemonPressCall {
+	return &lemonPressCall{Call: _m.Mock.On("Press"), Parent: _m}
+}
+
+func (_m *lemonMock) OnPressRaw() *lemonPressCall {
+	return &lemonPressCall{Call: _m.Mock.On("Press"), Parent: _m}
+}
+
+type lemonPressCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonPressCall) Panic(msg string) *lemonPressCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonPressCall) Once() *lemonPressCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonPressCall) Twice() *lemonPressCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonPressCall) Times(i int) *lemonPressCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonPressCall) WaitUntil(w <-chan time.Time) *lemonPressCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonPressCall) After(d time.Duration) *lemonPressCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonPressCall) Run(fn func(args mock.Arguments)) *lemonPressCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonPressCall) Maybe() *lemonPressCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonPressCall) TypedReturns(a interface {
+	io.Closer
+	Origin() module.Version
+	Read([]byte) (int, error)
+}) *lemonPressCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonPressCall) ReturnsFn(fn func() interface {
+	io.Closer
+	Origin() module.Version
+	Read([]byte) (int, error)
+}) *lemonPressCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonPressCall) TypedRun(fn func()) *lemonPressCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *lemonPressCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonPressCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonPressCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonPressCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonPressCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonPressCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonPressCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonPressCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonPressCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonPressCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonPressCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonPressCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonPressCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonPressCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonPressCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonPressCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonPressCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonPressCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonPressCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonPressCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonPressCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonPressCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonPressCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonPressCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonPressCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonPressCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Pulp() map[string]any {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() map[string]any); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(map[string]any)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnPulp() *lemonPulpCall {
+	return &lemonPulpCall{Call: _m.Mock.On("Pulp"), Parent: _m}
+}
+
+func (_m *lemonMock) OnPulpRaw() *lemonPulpCall {
+	return &lemonPulpCall{Call: _m.Mock.On("Pulp"), Parent: _m}
+}
+
+type lemonPulpCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonPulpCall) Panic(msg string) *lemonPulpCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonPulpCall) Once() *lemonPulpCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonPulpCall) Twice() *lemonPulpCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonPulpCall) Times(i int) *lemonPulpCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonPulpCall) WaitUntil(w <-chan time.Time) *lemonPulpCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonPulpCall) After(d time.Duration) *lemonPulpCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonPulpCall) Run(fn func(args mock.Arguments)) *lemonPulpCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonPulpCall) Maybe() *lemonPulpCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonPulpCall) TypedReturns(a map[string]any) *lemonPulpCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonPulpCall) ReturnsFn(fn func() map[string]any) *lemonPulpCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonPulpCall) TypedRun(fn func()) *lemonPulpCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *lemonPulpCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonPulpCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonPulpCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonPulpCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonPulpCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonPulpCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonPulpCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonPulpCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonPulpCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonPulpCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonPulpCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonPulpCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonPulpCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonPulpCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonPulpCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonPulpCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonPulpCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonPulpCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonPulpCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonPulpCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonPulpCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonPulpCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonPulpCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonPulpCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonPulpCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonPulpCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Register(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) error {
+	_ret := _m.Called(h, hooks)
+
+	if _rf, ok := _ret.Get(0).(func(*Orange, *interface {
+		io.Closer
+		Origin() module.Version
+	}) error); ok {
+		return _rf(h, hooks)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return &lemonRegisterCall{Call: _m.Mock.On("Register", h, hooks), Parent: _m}
+}
+
+// OnRegisterMatched is like OnRegister but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *lemonMock) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if h != nil {
+		_args[0] = mock.MatchedBy(h)
+	}
+
+	if hooks != nil {
+		_args[1] = mock.MatchedBy(hooks)
+	}
+
+	return &lemonRegisterCall{Call: _m.Mock.On("Register", _args...), Parent: _m}
+}
+
+func (_m *lemonMock) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return &lemonRegisterCall{Call: _m.Mock.On("Register", h, hooks), Parent: _m}
+}
+
+type lemonRegisterCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonRegisterCall) Panic(msg string) *lemonRegisterCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonRegisterCall) Once() *lemonRegisterCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonRegisterCall) Twice() *lemonRegisterCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonRegisterCall) Times(i int) *lemonRegisterCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonRegisterCall) WaitUntil(w <-chan time.Time) *lemonRegisterCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonRegisterCall) After(d time.Duration) *lemonRegisterCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonRegisterCall) Run(fn func(args mock.Arguments)) *lemonRegisterCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonRegisterCall) Maybe() *lemonRegisterCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonRegisterCall) TypedReturns(a error) *lemonRegisterCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonRegisterCall) ReturnsFn(fn func(*Orange, *interface {
+	io.Closer
+	Origin() module.Version
+}) error) *lemonRegisterCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonRegisterCall) TypedRun(fn func(*Orange, *interface {
+	io.Closer
+	Origin() module.Version
+})) *lemonRegisterCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_h, _ := args.Get(0).(*Orange)
+		_hooks, _ := args.Get(1).(*interface {
+			io.Closer
+			Origin() module.Version
+		})
+		fn(_h, _hooks)
+	})
+	return _c
+}
+
+func (_c *lemonRegisterCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonRegisterCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonRegisterCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonRegisterCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonRegisterCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonRegisterCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonRegisterCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonRegisterCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonRegisterCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonRegisterCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonRegisterCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonRegisterCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonRegisterCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonRegisterCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonRegisterCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonRegisterCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonRegisterCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonRegisterCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonRegisterCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonRegisterCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonRegisterCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonRegisterCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonRegisterCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonRegisterCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonRegisterCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonRegisterCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Seeds() <-chan module.Version {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() <-chan module.Version); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(<-chan module.Version)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnSeeds() *lemonSeedsCall {
+	return &lemonSeedsCall{Call: _m.Mock.On("Seeds"), Parent: _m}
+}
+
+func (_m *lemonMock) OnSeedsRaw() *lemonSeedsCall {
+	return &lemonSeedsCall{Call: _m.Mock.On("Seeds"), Parent: _m}
+}
+
+type lemonSeedsCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonSeedsCall) Panic(msg string) *lemonSeedsCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonSeedsCall) Once() *lemonSeedsCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonSeedsCall) Twice() *lemonSeedsCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonSeedsCall) Times(i int) *lemonSeedsCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonSeedsCall) WaitUntil(w <-chan time.Time) *lemonSeedsCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonSeedsCall) After(d time.Duration) *lemonSeedsCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonSeedsCall) Run(fn func(args mock.Arguments)) *lemonSeedsCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonSeedsCall) Maybe() *lemonSeedsCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonSeedsCall) TypedReturns(a <-chan module.Version) *lemonSeedsCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonSeedsCall) ReturnsFn(fn func() <-chan module.Version) *lemonSeedsCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonSeedsCall) TypedRun(fn func()) *lemonSeedsCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *lemonSeedsCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonSeedsCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonSeedsCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonSeedsCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonSeedsCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonSeedsCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonSeedsCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonSeedsCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonSeedsCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonSeedsCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonSeedsCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonSeedsCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonSeedsCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonSeedsCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonSeedsCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonSeedsCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonSeedsCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonSeedsCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonSeedsCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
+}
+
+func (_c *lemonSeedsCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
+}
+
+func (_c *lemonSeedsCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
+}
+
+func (_c *lemonSeedsCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
+}
+
+func (_c *lemonSeedsCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
+}
+
+func (_c *lemonSeedsCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
+}
+
+func (_c *lemonSeedsCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
+}
+
+func (_c *lemonSeedsCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
+}
+
+func (_m *lemonMock) Squeeze(n int) string {
+	_ret := _m.Called(n)
+
+	if _rf, ok := _ret.Get(0).(func(int) string); ok {
+		return _rf(n)
+	}
+
+	_ra0 := _ret.String(0)
+
+	return _ra0
+}
+
+func (_m *lemonMock) OnSqueeze(n int) *lemonSqueezeCall {
+	return &lemonSqueezeCall{Call: _m.Mock.On("Squeeze", n), Parent: _m}
+}
+
+// OnSqueezeMatched is like OnSqueeze but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *lemonMock) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	_args := []interface{}{mock.Anything}
+
+	if n != nil {
+		_args[0] = mock.MatchedBy(n)
+	}
+
+	return &lemonSqueezeCall{Call: _m.Mock.On("Squeeze", _args...), Parent: _m}
+}
+
+func (_m *lemonMock) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return &lemonSqueezeCall{Call: _m.Mock.On("Squeeze", n), Parent: _m}
+}
+
+type lemonSqueezeCall struct {
+	*mock.Call
+	Parent *lemonMock
+}
+
+func (_c *lemonSqueezeCall) Panic(msg string) *lemonSqueezeCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *lemonSqueezeCall) Once() *lemonSqueezeCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *lemonSqueezeCall) Twice() *lemonSqueezeCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *lemonSqueezeCall) Times(i int) *lemonSqueezeCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *lemonSqueezeCall) WaitUntil(w <-chan time.Time) *lemonSqueezeCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *lemonSqueezeCall) After(d time.Duration) *lemonSqueezeCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *lemonSqueezeCall) Run(fn func(args mock.Arguments)) *lemonSqueezeCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *lemonSqueezeCall) Maybe() *lemonSqueezeCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *lemonSqueezeCall) TypedReturns(a string) *lemonSqueezeCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *lemonSqueezeCall) ReturnsFn(fn func(int) string) *lemonSqueezeCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *lemonSqueezeCall) TypedRun(fn func(int)) *lemonSqueezeCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_n := args.Int(0)
+		fn(_n)
+	})
+	return _c
+}
+
+func (_c *lemonSqueezeCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
+}
+
+func (_c *lemonSqueezeCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
+}
+
+func (_c *lemonSqueezeCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
+}
+
+func (_c *lemonSqueezeCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
+}
+
+func (_c *lemonSqueezeCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
+}
+
+func (_c *lemonSqueezeCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
+}
+
+func (_c *lemonSqueezeCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
+}
+
+func (_c *lemonSqueezeCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
+}
+
+func (_c *lemonSqueezeCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
+}
+
+func (_c *lemonSqueezeCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
+}
+
+func (_c *lemonSqueezeCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
+}
+
+func (_c *lemonSqueezeCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
+}
+
+func (_c *lemonSqueezeCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
+}
+
+func (_c *lemonSqueezeCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
+}
+
+func (_c *lemonSqueezeCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
+}
+
+func (_c *lemonSqueezeCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
+}
+
+func (_c *lemonSqueezeCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
+}
+
+func (_c *lemonSqueezeCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
+}
+
+func (_c *lemonSqueezeCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
 }
 
-func (_c *coconutPooCall) OnMooRaw(fn interface{}) *coconutMooCall {
-	return _c.Parent.OnMooRaw(fn)
+func (_c *lemonSqueezeCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
 }
 
-func (_c *coconutPooCall) OnNooRaw(ar interface{}) *coconutNooCall {
-	return _c.Parent.OnNooRaw(ar)
+func (_c *lemonSqueezeCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
 }
 
-func (_c *coconutPooCall) OnPooRaw(str interface{}) *coconutPooCall {
-	return _c.Parent.OnPooRaw(str)
+func (_c *lemonSqueezeCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
 }
 
-func (_c *coconutPooCall) OnTooRaw(src interface{}) *coconutTooCall {
-	return _c.Parent.OnTooRaw(src)
+func (_c *lemonSqueezeCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
 }
 
-func (_c *coconutPooCall) OnVooRaw(src interface{}) *coconutVooCall {
-	return _c.Parent.OnVooRaw(src)
+func (_c *lemonSqueezeCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
 }
 
-func (_c *coconutPooCall) OnYooRaw(st interface{}) *coconutYooCall {
-	return _c.Parent.OnYooRaw(st)
+func (_c *lemonSqueezeCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
 }
 
-func (_c *coconutPooCall) OnZooRaw(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZooRaw(st)
+func (_c *lemonSqueezeCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
 }
 
-func (_m *coconutMock) Too(src string) time.Duration {
-	_ret := _m.Called(src)
+func (_m *lemonMock) Zest() (int, error) {
+	_ret := _m.Called()
 
-	if _rf, ok := _ret.Get(0).(func(string) time.Duration); ok {
-		return _rf(src)
+	if _rf, ok := _ret.Get(0).(func() (int, error)); ok {
+		return _rf()
 	}
 
-	_ra0, _ := _ret.Get(0).(time.Duration)
+	_ra0 := _ret.Int(0)
+	err := _ret.Error(1)
 
-	return _ra0
+	return _ra0, err
 }
 
-func (_m *coconutMock) OnToo(src string) *coconutTooCall {
-	return &coconutTooCall{Call: _m.Mock.On("Too", src), Parent: _m}
+func (_m *lemonMock) OnZest() *lemonZestCall {
+	return &lemonZestCall{Call: _m.Mock.On("Zest"), Parent: _m}
 }
 
-func (_m *coconutMock) OnTooRaw(src interface{}) *coconutTooCall {
-	return &coconutTooCall{Call: _m.Mock.On("Too", src), Parent: _m}
+func (_m *lemonMock) OnZestRaw() *lemonZestCall {
+	return &lemonZestCall{Call: _m.Mock.On("Zest"), Parent: _m}
 }
 
-type coconutTooCall struct {
+type lemonZestCall struct {
 	*mock.Call
-	Parent *coconutMock
+	Parent *lemonMock
 }
 
-func (_c *coconutTooCall) Panic(msg string) *coconutTooCall {
+func (_c *lemonZestCall) Panic(msg string) *lemonZestCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *coconutTooCall) Once() *coconutTooCall {
+func (_c *lemonZestCall) Once() *lemonZestCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *coconutTooCall) Twice() *coconutTooCall {
+func (_c *lemonZestCall) Twice() *lemonZestCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *coconutTooCall) Times(i int) *coconutTooCall {
+func (_c *lemonZestCall) Times(i int) *lemonZestCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *coconutTooCall) WaitUntil(w <-chan time.Time) *coconutTooCall {
+func (_c *lemonZestCall) WaitUntil(w <-chan time.Time) *lemonZestCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *coconutTooCall) After(d time.Duration) *coconutTooCall {
+func (_c *lemonZestCall) After(d time.Duration) *lemonZestCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *coconutTooCall) Run(fn func(args mock.Arguments)) *coconutTooCall {
+func (_c *lemonZestCall) Run(fn func(args mock.Arguments)) *lemonZestCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *coconutTooCall) Maybe() *coconutTooCall {
+func (_c *lemonZestCall) Maybe() *lemonZestCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *coconutTooCall) TypedReturns(a time.Duration) *coconutTooCall {
-	_c.Call = _c.Return(a)
+func (_c *lemonZestCall) TypedReturns(a int, d error) *lemonZestCall {
+	_c.Call = _c.Return(a, d)
 	return _c
 }
 
-func (_c *coconutTooCall) ReturnsFn(fn func(string) time.Duration) *coconutTooCall {
+func (_c *lemonZestCall) ReturnsFn(fn func() (int, error)) *lemonZestCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *coconutTooCall) TypedRun(fn func(string)) *coconutTooCall {
+// TypedReturnsError is like TypedReturns but returns the zero values with the error.
+func (_c *lemonZestCall) TypedReturnsError(err error) *lemonZestCall {
+	var a int
+
+	_c.Call = _c.Return(a, err)
+	return _c
+}
+
+func (_c *lemonZestCall) TypedRun(fn func()) *lemonZestCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_src := args.String(0)
-		fn(_src)
+		fn()
 	})
 	return _c
 }
 
-func (_c *coconutTooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
-	return _c.Parent.OnBoo(src)
+func (_c *lemonZestCall) OnAddress() *lemonAddressCall {
+	return _c.Parent.OnAddress()
 }
 
-func (_c *coconutTooCall) OnDoo(src time.Duration) *coconutDooCall {
-	return _c.Parent.OnDoo(src)
+func (_c *lemonZestCall) OnJuice() *lemonJuiceCall {
+	return _c.Parent.OnJuice()
 }
 
-func (_c *coconutTooCall) OnFoo(st Strawberry) *coconutFooCall {
-	return _c.Parent.OnFoo(st)
+func (_c *lemonZestCall) OnPeel() *lemonPeelCall {
+	return _c.Parent.OnPeel()
 }
 
-func (_c *coconutTooCall) OnGoo(st string) *coconutGooCall {
-	return _c.Parent.OnGoo(st)
+func (_c *lemonZestCall) OnPick(time_ time.Time, module_ module.Version) *lemonPickCall {
+	return _c.Parent.OnPick(time_, module_)
 }
 
-func (_c *coconutTooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
-	return _c.Parent.OnHoo(aParam, bParam, cParam)
+func (_c *lemonZestCall) OnPour(handlers map[string]func(context.Context) error) *lemonPourCall {
+	return _c.Parent.OnPour(handlers)
 }
 
-func (_c *coconutTooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
-	return _c.Parent.OnJoo(aParam, bParam, cParam)
+func (_c *lemonZestCall) OnPress() *lemonPressCall {
+	return _c.Parent.OnPress()
 }
 
-func (_c *coconutTooCall) OnKoo(src string) *coconutKooCall {
-	return _c.Parent.OnKoo(src)
+func (_c *lemonZestCall) OnPulp() *lemonPulpCall {
+	return _c.Parent.OnPulp()
 }
 
-func (_c *coconutTooCall) OnLoo(st string, values []int) *coconutLooCall {
-	return _c.Parent.OnLoo(st, values...)
+func (_c *lemonZestCall) OnRegister(h *Orange, hooks *interface {
+	io.Closer
+	Origin() module.Version
+}) *lemonRegisterCall {
+	return _c.Parent.OnRegister(h, hooks)
 }
 
-func (_c *coconutTooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
-	return _c.Parent.OnMoo(fn)
+func (_c *lemonZestCall) OnSeeds() *lemonSeedsCall {
+	return _c.Parent.OnSeeds()
 }
 
-func (_c *coconutTooCall) OnNoo(ar [][2]string) *coconutNooCall {
-	return _c.Parent.OnNoo(ar)
+func (_c *lemonZestCall) OnSqueeze(n int) *lemonSqueezeCall {
+	return _c.Parent.OnSqueeze(n)
 }
 
-func (_c *coconutTooCall) OnPoo(str struct{ name string }) *coconutPooCall {
-	return _c.Parent.OnPoo(str)
+func (_c *lemonZestCall) OnZest() *lemonZestCall {
+	return _c.Parent.OnZest()
 }
 
-func (_c *coconutTooCall) OnToo(src string) *coconutTooCall {
-	return _c.Parent.OnToo(src)
+func (_c *lemonZestCall) OnPickMatched(time_ func(time.Time) bool, module_ func(module.Version) bool) *lemonPickCall {
+	return _c.Parent.OnPickMatched(time_, module_)
 }
 
-func (_c *coconutTooCall) OnVoo(src *module.Version) *coconutVooCall {
-	return _c.Parent.OnVoo(src)
+func (_c *lemonZestCall) OnPourMatched(handlers func(map[string]func(context.Context) error) bool) *lemonPourCall {
+	return _c.Parent.OnPourMatched(handlers)
 }
 
-func (_c *coconutTooCall) OnYoo(st string) *coconutYooCall {
-	return _c.Parent.OnYoo(st)
+func (_c *lemonZestCall) OnRegisterMatched(h func(*Orange) bool, hooks func(*interface {
+	io.Closer
+	Origin() module.Version
+}) bool) *lemonRegisterCall {
+	return _c.Parent.OnRegisterMatched(h, hooks)
 }
 
-func (_c *coconutTooCall) OnZoo(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZoo(st)
+func (_c *lemonZestCall) OnSqueezeMatched(n func(int) bool) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeMatched(n)
 }
 
-func (_c *coconutTooCall) OnBooRaw(src interface{}) *coconutBooCall {
-	return _c.Parent.OnBooRaw(src)
+func (_c *lemonZestCall) OnAddressRaw() *lemonAddressCall {
+	return _c.Parent.OnAddressRaw()
 }
 
-func (_c *coconutTooCall) OnDooRaw(src interface{}) *coconutDooCall {
-	return _c.Parent.OnDooRaw(src)
+func (_c *lemonZestCall) OnJuiceRaw() *lemonJuiceCall {
+	return _c.Parent.OnJuiceRaw()
 }
 
-func (_c *coconutTooCall) OnFooRaw(st interface{}) *coconutFooCall {
-	return _c.Parent.OnFooRaw(st)
+func (_c *lemonZestCall) OnPeelRaw() *lemonPeelCall {
+	return _c.Parent.OnPeelRaw()
 }
 
-func (_c *coconutTooCall) OnGooRaw(st interface{}) *coconutGooCall {
-	return _c.Parent.OnGooRaw(st)
+func (_c *lemonZestCall) OnPickRaw(time_ interface{}, module_ interface{}) *lemonPickCall {
+	return _c.Parent.OnPickRaw(time_, module_)
 }
 
-func (_c *coconutTooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
-	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+func (_c *lemonZestCall) OnPourRaw(handlers interface{}) *lemonPourCall {
+	return _c.Parent.OnPourRaw(handlers)
 }
 
-func (_c *coconutTooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
-	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+func (_c *lemonZestCall) OnPressRaw() *lemonPressCall {
+	return _c.Parent.OnPressRaw()
 }
 
-func (_c *coconutTooCall) OnKooRaw(src interface{}) *coconutKooCall {
-	return _c.Parent.OnKooRaw(src)
+func (_c *lemonZestCall) OnPulpRaw() *lemonPulpCall {
+	return _c.Parent.OnPulpRaw()
 }
 
-func (_c *coconutTooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
-	return _c.Parent.OnLooRaw(st, values)
+func (_c *lemonZestCall) OnRegisterRaw(h interface{}, hooks interface{}) *lemonRegisterCall {
+	return _c.Parent.OnRegisterRaw(h, hooks)
 }
 
-func (_c *coconutTooCall) OnMooRaw(fn interface{}) *coconutMooCall {
-	return _c.Parent.OnMooRaw(fn)
+func (_c *lemonZestCall) OnSeedsRaw() *lemonSeedsCall {
+	return _c.Parent.OnSeedsRaw()
 }
 
-func (_c *coconutTooCall) OnNooRaw(ar interface{}) *coconutNooCall {
-	return _c.Parent.OnNooRaw(ar)
+func (_c *lemonZestCall) OnSqueezeRaw(n interface{}) *lemonSqueezeCall {
+	return _c.Parent.OnSqueezeRaw(n)
 }
 
-func (_c *coconutTooCall) OnPooRaw(str interface{}) *coconutPooCall {
-	return _c.Parent.OnPooRaw(str)
+func (_c *lemonZestCall) OnZestRaw() *lemonZestCall {
+	return _c.Parent.OnZestRaw()
 }
 
-func (_c *coconutTooCall) OnTooRaw(src interface{}) *coconutTooCall {
-	return _c.Parent.OnTooRaw(src)
-}
+// orderedMock is a mock of the Ordered interface (generated by mocktail).
+type orderedMock[T Ordered[T]] struct{ mock.Mock }
 
-func (_c *coconutTooCall) OnVooRaw(src interface{}) *coconutVooCall {
-	return _c.Parent.OnVooRaw(src)
-}
+// newOrderedMock creates a new orderedMock.
+func newOrderedMock[T Ordered[T]](tb testing.TB) *orderedMock[T] {
+	tb.Helper()
 
-func (_c *coconutTooCall) OnYooRaw(st interface{}) *coconutYooCall {
-	return _c.Parent.OnYooRaw(st)
-}
+	m := &orderedMock[T]{}
+	m.Mock.Test(tb)
 
-func (_c *coconutTooCall) OnZooRaw(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZooRaw(st)
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
 }
 
-func (_m *coconutMock) Voo(src *module.Version) time.Duration {
-	_ret := _m.Called(src)
+func (_m *orderedMock[T]) Less(other T) bool {
+	_ret := _m.Called(other)
 
-	if _rf, ok := _ret.Get(0).(func(*module.Version) time.Duration); ok {
-		return _rf(src)
+	if _rf, ok := _ret.Get(0).(func(T) bool); ok {
+		return _rf(other)
 	}
 
-	_ra0, _ := _ret.Get(0).(time.Duration)
+	_ra0 := _ret.Bool(0)
 
 	return _ra0
 }
 
-func (_m *coconutMock) OnVoo(src *module.Version) *coconutVooCall {
-	return &coconutVooCall{Call: _m.Mock.On("Voo", src), Parent: _m}
+func (_m *orderedMock[T]) OnLess(other T) *orderedLessCall[T] {
+	return &orderedLessCall[T]{Call: _m.Mock.On("Less", other), Parent: _m}
 }
 
-func (_m *coconutMock) OnVooRaw(src interface{}) *coconutVooCall {
-	return &coconutVooCall{Call: _m.Mock.On("Voo", src), Parent: _m}
+// OnLessMatched is like OnLess but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *orderedMock[T]) OnLessMatched(other func(T) bool) *orderedLessCall[T] {
+	_args := []interface{}{mock.Anything}
+
+	if other != nil {
+		_args[0] = mock.MatchedBy(other)
+	}
+
+	return &orderedLessCall[T]{Call: _m.Mock.On("Less", _args...), Parent: _m}
 }
 
-type coconutVooCall struct {
+func (_m *orderedMock[T]) OnLessRaw(other interface{}) *orderedLessCall[T] {
+	return &orderedLessCall[T]{Call: _m.Mock.On("Less", other), Parent: _m}
+}
+
+type orderedLessCall[T Ordered[T]] struct {
 	*mock.Call
-	Parent *coconutMock
+	Parent *orderedMock[T]
 }
 
-func (_c *coconutVooCall) Panic(msg string) *coconutVooCall {
+func (_c *orderedLessCall[T]) Panic(msg string) *orderedLessCall[T] {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *coconutVooCall) Once() *coconutVooCall {
+func (_c *orderedLessCall[T]) Once() *orderedLessCall[T] {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *coconutVooCall) Twice() *coconutVooCall {
+func (_c *orderedLessCall[T]) Twice() *orderedLessCall[T] {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *coconutVooCall) Times(i int) *coconutVooCall {
+func (_c *orderedLessCall[T]) Times(i int) *orderedLessCall[T] {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *coconutVooCall) WaitUntil(w <-chan time.Time) *coconutVooCall {
+func (_c *orderedLessCall[T]) WaitUntil(w <-chan time.Time) *orderedLessCall[T] {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *coconutVooCall) After(d time.Duration) *coconutVooCall {
+func (_c *orderedLessCall[T]) After(d time.Duration) *orderedLessCall[T] {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *coconutVooCall) Run(fn func(args mock.Arguments)) *coconutVooCall {
+func (_c *orderedLessCall[T]) Run(fn func(args mock.Arguments)) *orderedLessCall[T] {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *coconutVooCall) Maybe() *coconutVooCall {
+func (_c *orderedLessCall[T]) Maybe() *orderedLessCall[T] {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *coconutVooCall) TypedReturns(a time.Duration) *coconutVooCall {
+func (_c *orderedLessCall[T]) TypedReturns(a bool) *orderedLessCall[T] {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *coconutVooCall) ReturnsFn(fn func(*module.Version) time.Duration) *coconutVooCall {
+func (_c *orderedLessCall[T]) ReturnsFn(fn func(T) bool) *orderedLessCall[T] {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *coconutVooCall) TypedRun(fn func(*module.Version)) *coconutVooCall {
+func (_c *orderedLessCall[T]) TypedRun(fn func(T)) *orderedLessCall[T] {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_src, _ := args.Get(0).(*module.Version)
-		fn(_src)
+		_other, _ := args.Get(0).(T)
+		fn(_other)
 	})
 	return _c
 }
 
-func (_c *coconutVooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
-	return _c.Parent.OnBoo(src)
+func (_c *orderedLessCall[T]) OnLess(other T) *orderedLessCall[T] {
+	return _c.Parent.OnLess(other)
 }
 
-func (_c *coconutVooCall) OnDoo(src time.Duration) *coconutDooCall {
-	return _c.Parent.OnDoo(src)
+func (_c *orderedLessCall[T]) OnLessMatched(other func(T) bool) *orderedLessCall[T] {
+	return _c.Parent.OnLessMatched(other)
 }
 
-func (_c *coconutVooCall) OnFoo(st Strawberry) *coconutFooCall {
-	return _c.Parent.OnFoo(st)
+func (_c *orderedLessCall[T]) OnLessRaw(other interface{}) *orderedLessCall[T] {
+	return _c.Parent.OnLessRaw(other)
 }
 
-func (_c *coconutVooCall) OnGoo(st string) *coconutGooCall {
-	return _c.Parent.OnGoo(st)
-}
+// loggerMock is a mock of the Logger interface (generated by mocktail).
+type loggerMock struct{ mock.Mock }
 
-func (_c *coconutVooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
-	return _c.Parent.OnHoo(aParam, bParam, cParam)
-}
+// newLoggerMock creates a new loggerMock.
+func newLoggerMock(tb testing.TB) *loggerMock {
+	tb.Helper()
 
-func (_c *coconutVooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
-	return _c.Parent.OnJoo(aParam, bParam, cParam)
-}
+	m := &loggerMock{}
+	m.Mock.Test(tb)
 
-func (_c *coconutVooCall) OnKoo(src string) *coconutKooCall {
-	return _c.Parent.OnKoo(src)
-}
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
 
-func (_c *coconutVooCall) OnLoo(st string, values []int) *coconutLooCall {
-	return _c.Parent.OnLoo(st, values...)
+	return m
 }
 
-func (_c *coconutVooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
-	return _c.Parent.OnMoo(fn)
+func (_m *loggerMock) Log(args ...any) {
+	_m.Called(args)
 }
 
-func (_c *coconutVooCall) OnNoo(ar [][2]string) *coconutNooCall {
-	return _c.Parent.OnNoo(ar)
+func (_m *loggerMock) OnLog(args ...any) *loggerLogCall {
+	return &loggerLogCall{Call: _m.Mock.On("Log", args), Parent: _m}
 }
 
-func (_c *coconutVooCall) OnPoo(str struct{ name string }) *coconutPooCall {
-	return _c.Parent.OnPoo(str)
-}
+// OnLogMatched is like OnLog but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *loggerMock) OnLogMatched(args func([]any) bool) *loggerLogCall {
+	_args := []interface{}{mock.Anything}
 
-func (_c *coconutVooCall) OnToo(src string) *coconutTooCall {
-	return _c.Parent.OnToo(src)
-}
+	if args != nil {
+		_args[0] = mock.MatchedBy(args)
+	}
 
-func (_c *coconutVooCall) OnVoo(src *module.Version) *coconutVooCall {
-	return _c.Parent.OnVoo(src)
+	return &loggerLogCall{Call: _m.Mock.On("Log", _args...), Parent: _m}
 }
 
-func (_c *coconutVooCall) OnYoo(st string) *coconutYooCall {
-	return _c.Parent.OnYoo(st)
+func (_m *loggerMock) OnLogRaw(args interface{}) *loggerLogCall {
+	return &loggerLogCall{Call: _m.Mock.On("Log", args), Parent: _m}
 }
 
-func (_c *coconutVooCall) OnZoo(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZoo(st)
+type loggerLogCall struct {
+	*mock.Call
+	Parent *loggerMock
 }
 
-func (_c *coconutVooCall) OnBooRaw(src interface{}) *coconutBooCall {
-	return _c.Parent.OnBooRaw(src)
+func (_c *loggerLogCall) Panic(msg string) *loggerLogCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
 }
 
-func (_c *coconutVooCall) OnDooRaw(src interface{}) *coconutDooCall {
-	return _c.Parent.OnDooRaw(src)
+func (_c *loggerLogCall) Once() *loggerLogCall {
+	_c.Call = _c.Call.Once()
+	return _c
 }
 
-func (_c *coconutVooCall) OnFooRaw(st interface{}) *coconutFooCall {
-	return _c.Parent.OnFooRaw(st)
+func (_c *loggerLogCall) Twice() *loggerLogCall {
+	_c.Call = _c.Call.Twice()
+	return _c
 }
 
-func (_c *coconutVooCall) OnGooRaw(st interface{}) *coconutGooCall {
-	return _c.Parent.OnGooRaw(st)
+func (_c *loggerLogCall) Times(i int) *loggerLogCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
 }
 
-func (_c *coconutVooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
-	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+func (_c *loggerLogCall) WaitUntil(w <-chan time.Time) *loggerLogCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
 }
 
-func (_c *coconutVooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
-	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+func (_c *loggerLogCall) After(d time.Duration) *loggerLogCall {
+	_c.Call = _c.Call.After(d)
+	return _c
 }
 
-func (_c *coconutVooCall) OnKooRaw(src interface{}) *coconutKooCall {
-	return _c.Parent.OnKooRaw(src)
+func (_c *loggerLogCall) Run(fn func(args mock.Arguments)) *loggerLogCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
 }
 
-func (_c *coconutVooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
-	return _c.Parent.OnLooRaw(st, values)
+func (_c *loggerLogCall) Maybe() *loggerLogCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
 }
 
-func (_c *coconutVooCall) OnMooRaw(fn interface{}) *coconutMooCall {
-	return _c.Parent.OnMooRaw(fn)
+func (_c *loggerLogCall) TypedRun(fn func(...any)) *loggerLogCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_args, _ := args.Get(0).([]any)
+		fn(_args...)
+	})
+	return _c
 }
 
-func (_c *coconutVooCall) OnNooRaw(ar interface{}) *coconutNooCall {
-	return _c.Parent.OnNooRaw(ar)
+func (_c *loggerLogCall) OnLog(args ...any) *loggerLogCall {
+	return _c.Parent.OnLog(args...)
 }
 
-func (_c *coconutVooCall) OnPooRaw(str interface{}) *coconutPooCall {
-	return _c.Parent.OnPooRaw(str)
+func (_c *loggerLogCall) OnLogf(format string, args ...any) *loggerLogfCall {
+	return _c.Parent.OnLogf(format, args...)
 }
 
-func (_c *coconutVooCall) OnTooRaw(src interface{}) *coconutTooCall {
-	return _c.Parent.OnTooRaw(src)
+func (_c *loggerLogCall) OnLogMatched(args func([]any) bool) *loggerLogCall {
+	return _c.Parent.OnLogMatched(args)
 }
 
-func (_c *coconutVooCall) OnVooRaw(src interface{}) *coconutVooCall {
-	return _c.Parent.OnVooRaw(src)
+func (_c *loggerLogCall) OnLogfMatched(format func(string) bool, args func([]any) bool) *loggerLogfCall {
+	return _c.Parent.OnLogfMatched(format, args)
 }
 
-func (_c *coconutVooCall) OnYooRaw(st interface{}) *coconutYooCall {
-	return _c.Parent.OnYooRaw(st)
+func (_c *loggerLogCall) OnLogRaw(args interface{}) *loggerLogCall {
+	return _c.Parent.OnLogRaw(args)
 }
 
-func (_c *coconutVooCall) OnZooRaw(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZooRaw(st)
+func (_c *loggerLogCall) OnLogfRaw(format interface{}, args interface{}) *loggerLogfCall {
+	return _c.Parent.OnLogfRaw(format, args)
 }
 
-func (_m *coconutMock) Yoo(st string) interface{} {
-	_ret := _m.Called(st)
+func (_m *loggerMock) Logf(format string, args ...any) int {
+	_ret := _m.Called(format, args)
 
-	if _rf, ok := _ret.Get(0).(func(string) interface{}); ok {
-		return _rf(st)
+	if _rf, ok := _ret.Get(0).(func(string, ...any) int); ok {
+		return _rf(format, args...)
 	}
 
-	_ra0, _ := _ret.Get(0).(interface{})
+	_ra0 := _ret.Int(0)
 
 	return _ra0
 }
 
-func (_m *coconutMock) OnYoo(st string) *coconutYooCall {
-	return &coconutYooCall{Call: _m.Mock.On("Yoo", st), Parent: _m}
+func (_m *loggerMock) OnLogf(format string, args ...any) *loggerLogfCall {
+	return &loggerLogfCall{Call: _m.Mock.On("Logf", format, args), Parent: _m}
 }
 
-func (_m *coconutMock) OnYooRaw(st interface{}) *coconutYooCall {
-	return &coconutYooCall{Call: _m.Mock.On("Yoo", st), Parent: _m}
+// OnLogfMatched is like OnLogf but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *loggerMock) OnLogfMatched(format func(string) bool, args func([]any) bool) *loggerLogfCall {
+	_args := []interface{}{mock.Anything, mock.Anything}
+
+	if format != nil {
+		_args[0] = mock.MatchedBy(format)
+	}
+
+	if args != nil {
+		_args[1] = mock.MatchedBy(args)
+	}
+
+	return &loggerLogfCall{Call: _m.Mock.On("Logf", _args...), Parent: _m}
 }
 
-type coconutYooCall struct {
+func (_m *loggerMock) OnLogfRaw(format interface{}, args interface{}) *loggerLogfCall {
+	return &loggerLogfCall{Call: _m.Mock.On("Logf", format, args), Parent: _m}
+}
+
+type loggerLogfCall struct {
 	*mock.Call
-	Parent *coconutMock
+	Parent *loggerMock
 }
 
-func (_c *coconutYooCall) Panic(msg string) *coconutYooCall {
+func (_c *loggerLogfCall) Panic(msg string) *loggerLogfCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *coconutYooCall) Once() *coconutYooCall {
+func (_c *loggerLogfCall) Once() *loggerLogfCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *coconutYooCall) Twice() *coconutYooCall {
+func (_c *loggerLogfCall) Twice() *loggerLogfCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *coconutYooCall) Times(i int) *coconutYooCall {
+func (_c *loggerLogfCall) Times(i int) *loggerLogfCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *coconutYooCall) WaitUntil(w <-chan time.Time) *coconutYooCall {
+func (_c *loggerLogfCall) WaitUntil(w <-chan time.Time) *loggerLogfCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *coconutYooCall) After(d time.Duration) *coconutYooCall {
+func (_c *loggerLogfCall) After(d time.Duration) *loggerLogfCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *coconutYooCall) Run(fn func(args mock.Arguments)) *coconutYooCall {
+func (_c *loggerLogfCall) Run(fn func(args mock.Arguments)) *loggerLogfCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *coconutYooCall) Maybe() *coconutYooCall {
+func (_c *loggerLogfCall) Maybe() *loggerLogfCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *coconutYooCall) TypedReturns(a interface{}) *coconutYooCall {
+func (_c *loggerLogfCall) TypedReturns(a int) *loggerLogfCall {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *coconutYooCall) ReturnsFn(fn func(string) interface{}) *coconutYooCall {
+func (_c *loggerLogfCall) ReturnsFn(fn func(string, ...any) int) *loggerLogfCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *coconutYooCall) TypedRun(fn func(string)) *coconutYooCall {
+func (_c *loggerLogfCall) TypedRun(fn func(string, ...any)) *loggerLogfCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_st := args.String(0)
-		fn(_st)
+		_format := args.String(0)
+		_args, _ := args.Get(1).([]any)
+		fn(_format, _args...)
 	})
 	return _c
 }
 
-func (_c *coconutYooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
-	return _c.Parent.OnBoo(src)
+func (_c *loggerLogfCall) OnLog(args ...any) *loggerLogCall {
+	return _c.Parent.OnLog(args...)
 }
 
-func (_c *coconutYooCall) OnDoo(src time.Duration) *coconutDooCall {
-	return _c.Parent.OnDoo(src)
+func (_c *loggerLogfCall) OnLogf(format string, args ...any) *loggerLogfCall {
+	return _c.Parent.OnLogf(format, args...)
 }
 
-func (_c *coconutYooCall) OnFoo(st Strawberry) *coconutFooCall {
-	return _c.Parent.OnFoo(st)
+func (_c *loggerLogfCall) OnLogMatched(args func([]any) bool) *loggerLogCall {
+	return _c.Parent.OnLogMatched(args)
 }
 
-func (_c *coconutYooCall) OnGoo(st string) *coconutGooCall {
-	return _c.Parent.OnGoo(st)
+func (_c *loggerLogfCall) OnLogfMatched(format func(string) bool, args func([]any) bool) *loggerLogfCall {
+	return _c.Parent.OnLogfMatched(format, args)
 }
 
-func (_c *coconutYooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
-	return _c.Parent.OnHoo(aParam, bParam, cParam)
+func (_c *loggerLogfCall) OnLogRaw(args interface{}) *loggerLogCall {
+	return _c.Parent.OnLogRaw(args)
 }
 
-func (_c *coconutYooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
-	return _c.Parent.OnJoo(aParam, bParam, cParam)
+func (_c *loggerLogfCall) OnLogfRaw(format interface{}, args interface{}) *loggerLogfCall {
+	return _c.Parent.OnLogfRaw(format, args)
 }
 
-func (_c *coconutYooCall) OnKoo(src string) *coconutKooCall {
-	return _c.Parent.OnKoo(src)
-}
+// feedMock is a mock of the Feed interface (generated by mocktail).
+type feedMock[T any, V any] struct{ mock.Mock }
 
-func (_c *coconutYooCall) OnLoo(st string, values []int) *coconutLooCall {
-	return _c.Parent.OnLoo(st, values...)
+// newFeedMock creates a new feedMock.
+func newFeedMock[T any, V any](tb testing.TB) *feedMock[T, V] {
+	tb.Helper()
+
+	m := &feedMock[T, V]{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
 }
 
-func (_c *coconutYooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
-	return _c.Parent.OnMoo(fn)
+func (_m *feedMock[T, V]) Events() chan *j.Event[T] {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() chan *j.Event[T]); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(chan *j.Event[T])
+
+	return _ra0
 }
 
-func (_c *coconutYooCall) OnNoo(ar [][2]string) *coconutNooCall {
-	return _c.Parent.OnNoo(ar)
+func (_m *feedMock[T, V]) OnEvents() *feedEventsCall[T, V] {
+	return &feedEventsCall[T, V]{Call: _m.Mock.On("Events"), Parent: _m}
 }
 
-func (_c *coconutYooCall) OnPoo(str struct{ name string }) *coconutPooCall {
-	return _c.Parent.OnPoo(str)
+func (_m *feedMock[T, V]) OnEventsRaw() *feedEventsCall[T, V] {
+	return &feedEventsCall[T, V]{Call: _m.Mock.On("Events"), Parent: _m}
 }
 
-func (_c *coconutYooCall) OnToo(src string) *coconutTooCall {
-	return _c.Parent.OnToo(src)
+type feedEventsCall[T any, V any] struct {
+	*mock.Call
+	Parent *feedMock[T, V]
 }
 
-func (_c *coconutYooCall) OnVoo(src *module.Version) *coconutVooCall {
-	return _c.Parent.OnVoo(src)
+func (_c *feedEventsCall[T, V]) Panic(msg string) *feedEventsCall[T, V] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
 }
 
-func (_c *coconutYooCall) OnYoo(st string) *coconutYooCall {
-	return _c.Parent.OnYoo(st)
+func (_c *feedEventsCall[T, V]) Once() *feedEventsCall[T, V] {
+	_c.Call = _c.Call.Once()
+	return _c
 }
 
-func (_c *coconutYooCall) OnZoo(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZoo(st)
+func (_c *feedEventsCall[T, V]) Twice() *feedEventsCall[T, V] {
+	_c.Call = _c.Call.Twice()
+	return _c
 }
 
-func (_c *coconutYooCall) OnBooRaw(src interface{}) *coconutBooCall {
-	return _c.Parent.OnBooRaw(src)
+func (_c *feedEventsCall[T, V]) Times(i int) *feedEventsCall[T, V] {
+	_c.Call = _c.Call.Times(i)
+	return _c
 }
 
-func (_c *coconutYooCall) OnDooRaw(src interface{}) *coconutDooCall {
-	return _c.Parent.OnDooRaw(src)
+func (_c *feedEventsCall[T, V]) WaitUntil(w <-chan time.Time) *feedEventsCall[T, V] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
 }
 
-func (_c *coconutYooCall) OnFooRaw(st interface{}) *coconutFooCall {
-	return _c.Parent.OnFooRaw(st)
+func (_c *feedEventsCall[T, V]) After(d time.Duration) *feedEventsCall[T, V] {
+	_c.Call = _c.Call.After(d)
+	return _c
 }
 
-func (_c *coconutYooCall) OnGooRaw(st interface{}) *coconutGooCall {
-	return _c.Parent.OnGooRaw(st)
+func (_c *feedEventsCall[T, V]) Run(fn func(args mock.Arguments)) *feedEventsCall[T, V] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
 }
 
-func (_c *coconutYooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
-	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+func (_c *feedEventsCall[T, V]) Maybe() *feedEventsCall[T, V] {
+	_c.Call = _c.Call.Maybe()
+	return _c
 }
 
-func (_c *coconutYooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
-	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+func (_c *feedEventsCall[T, V]) TypedReturns(a chan *j.Event[T]) *feedEventsCall[T, V] {
+	_c.Call = _c.Return(a)
+	return _c
 }
 
-func (_c *coconutYooCall) OnKooRaw(src interface{}) *coconutKooCall {
-	return _c.Parent.OnKooRaw(src)
+func (_c *feedEventsCall[T, V]) ReturnsFn(fn func() chan *j.Event[T]) *feedEventsCall[T, V] {
+	_c.Call = _c.Return(fn)
+	return _c
 }
 
-func (_c *coconutYooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
-	return _c.Parent.OnLooRaw(st, values)
+func (_c *feedEventsCall[T, V]) TypedRun(fn func()) *feedEventsCall[T, V] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
 }
 
-func (_c *coconutYooCall) OnMooRaw(fn interface{}) *coconutMooCall {
-	return _c.Parent.OnMooRaw(fn)
+func (_c *feedEventsCall[T, V]) OnEvents() *feedEventsCall[T, V] {
+	return _c.Parent.OnEvents()
 }
 
-func (_c *coconutYooCall) OnNooRaw(ar interface{}) *coconutNooCall {
-	return _c.Parent.OnNooRaw(ar)
+func (_c *feedEventsCall[T, V]) OnList() *feedListCall[T, V] {
+	return _c.Parent.OnList()
 }
 
-func (_c *coconutYooCall) OnPooRaw(str interface{}) *coconutPooCall {
-	return _c.Parent.OnPooRaw(str)
+func (_c *feedEventsCall[T, V]) OnResults(ids []g.ID) *feedResultsCall[T, V] {
+	return _c.Parent.OnResults(ids)
 }
 
-func (_c *coconutYooCall) OnTooRaw(src interface{}) *coconutTooCall {
-	return _c.Parent.OnTooRaw(src)
+func (_c *feedEventsCall[T, V]) OnResultsMatched(ids func([]g.ID) bool) *feedResultsCall[T, V] {
+	return _c.Parent.OnResultsMatched(ids)
 }
 
-func (_c *coconutYooCall) OnVooRaw(src interface{}) *coconutVooCall {
-	return _c.Parent.OnVooRaw(src)
+func (_c *feedEventsCall[T, V]) OnEventsRaw() *feedEventsCall[T, V] {
+	return _c.Parent.OnEventsRaw()
 }
 
-func (_c *coconutYooCall) OnYooRaw(st interface{}) *coconutYooCall {
-	return _c.Parent.OnYooRaw(st)
+func (_c *feedEventsCall[T, V]) OnListRaw() *feedListCall[T, V] {
+	return _c.Parent.OnListRaw()
 }
 
-func (_c *coconutYooCall) OnZooRaw(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZooRaw(st)
+func (_c *feedEventsCall[T, V]) OnResultsRaw(ids interface{}) *feedResultsCall[T, V] {
+	return _c.Parent.OnResultsRaw(ids)
 }
 
-func (_m *coconutMock) Zoo(st interface{}) string {
-	_ret := _m.Called(st)
+func (_m *feedMock[T, V]) List() []*j.Page[g.User] {
+	_ret := _m.Called()
 
-	if _rf, ok := _ret.Get(0).(func(interface{}) string); ok {
-		return _rf(st)
+	if _rf, ok := _ret.Get(0).(func() []*j.Page[g.User]); ok {
+		return _rf()
 	}
 
-	_ra0 := _ret.String(0)
+	_ra0, _ := _ret.Get(0).([]*j.Page[g.User])
 
 	return _ra0
 }
 
-func (_m *coconutMock) OnZoo(st interface{}) *coconutZooCall {
-	return &coconutZooCall{Call: _m.Mock.On("Zoo", st), Parent: _m}
+func (_m *feedMock[T, V]) OnList() *feedListCall[T, V] {
+	return &feedListCall[T, V]{Call: _m.Mock.On("List"), Parent: _m}
 }
 
-func (_m *coconutMock) OnZooRaw(st interface{}) *coconutZooCall {
-	return &coconutZooCall{Call: _m.Mock.On("Zoo", st), Parent: _m}
+func (_m *feedMock[T, V]) OnListRaw() *feedListCall[T, V] {
+	return &feedListCall[T, V]{Call: _m.Mock.On("List"), Parent: _m}
 }
 
-type coconutZooCall struct {
+type feedListCall[T any, V any] struct {
 	*mock.Call
-	Parent *coconutMock
+	Parent *feedMock[T, V]
 }
 
-func (_c *coconutZooCall) Panic(msg string) *coconutZooCall {
+func (_c *feedListCall[T, V]) Panic(msg string) *feedListCall[T, V] {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *coconutZooCall) Once() *coconutZooCall {
+func (_c *feedListCall[T, V]) Once() *feedListCall[T, V] {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *coconutZooCall) Twice() *coconutZooCall {
+func (_c *feedListCall[T, V]) Twice() *feedListCall[T, V] {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *coconutZooCall) Times(i int) *coconutZooCall {
+func (_c *feedListCall[T, V]) Times(i int) *feedListCall[T, V] {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *coconutZooCall) WaitUntil(w <-chan time.Time) *coconutZooCall {
+func (_c *feedListCall[T, V]) WaitUntil(w <-chan time.Time) *feedListCall[T, V] {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *coconutZooCall) After(d time.Duration) *coconutZooCall {
+func (_c *feedListCall[T, V]) After(d time.Duration) *feedListCall[T, V] {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *coconutZooCall) Run(fn func(args mock.Arguments)) *coconutZooCall {
+func (_c *feedListCall[T, V]) Run(fn func(args mock.Arguments)) *feedListCall[T, V] {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *coconutZooCall) Maybe() *coconutZooCall {
+func (_c *feedListCall[T, V]) Maybe() *feedListCall[T, V] {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *coconutZooCall) TypedReturns(a string) *coconutZooCall {
+func (_c *feedListCall[T, V]) TypedReturns(a []*j.Page[g.User]) *feedListCall[T, V] {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *coconutZooCall) ReturnsFn(fn func(interface{}) string) *coconutZooCall {
+func (_c *feedListCall[T, V]) ReturnsFn(fn func() []*j.Page[g.User]) *feedListCall[T, V] {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *coconutZooCall) TypedRun(fn func(interface{})) *coconutZooCall {
+func (_c *feedListCall[T, V]) TypedRun(fn func()) *feedListCall[T, V] {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_st, _ := args.Get(0).(interface{})
-		fn(_st)
+		fn()
 	})
 	return _c
 }
 
-func (_c *coconutZooCall) OnBoo(src *bytes.Buffer) *coconutBooCall {
-	return _c.Parent.OnBoo(src)
+func (_c *feedListCall[T, V]) OnEvents() *feedEventsCall[T, V] {
+	return _c.Parent.OnEvents()
 }
 
-func (_c *coconutZooCall) OnDoo(src time.Duration) *coconutDooCall {
-	return _c.Parent.OnDoo(src)
+func (_c *feedListCall[T, V]) OnList() *feedListCall[T, V] {
+	return _c.Parent.OnList()
 }
 
-func (_c *coconutZooCall) OnFoo(st Strawberry) *coconutFooCall {
-	return _c.Parent.OnFoo(st)
+func (_c *feedListCall[T, V]) OnResults(ids []g.ID) *feedResultsCall[T, V] {
+	return _c.Parent.OnResults(ids)
 }
 
-func (_c *coconutZooCall) OnGoo(st string) *coconutGooCall {
-	return _c.Parent.OnGoo(st)
+func (_c *feedListCall[T, V]) OnResultsMatched(ids func([]g.ID) bool) *feedResultsCall[T, V] {
+	return _c.Parent.OnResultsMatched(ids)
 }
 
-func (_c *coconutZooCall) OnHoo(aParam string, bParam int, cParam Water) *coconutHooCall {
-	return _c.Parent.OnHoo(aParam, bParam, cParam)
+func (_c *feedListCall[T, V]) OnEventsRaw() *feedEventsCall[T, V] {
+	return _c.Parent.OnEventsRaw()
 }
 
-func (_c *coconutZooCall) OnJoo(aParam string, bParam int, cParam Water) *coconutJooCall {
-	return _c.Parent.OnJoo(aParam, bParam, cParam)
+func (_c *feedListCall[T, V]) OnListRaw() *feedListCall[T, V] {
+	return _c.Parent.OnListRaw()
 }
 
-func (_c *coconutZooCall) OnKoo(src string) *coconutKooCall {
-	return _c.Parent.OnKoo(src)
+func (_c *feedListCall[T, V]) OnResultsRaw(ids interface{}) *feedResultsCall[T, V] {
+	return _c.Parent.OnResultsRaw(ids)
 }
 
-func (_c *coconutZooCall) OnLoo(st string, values []int) *coconutLooCall {
-	return _c.Parent.OnLoo(st, values...)
+func (_m *feedMock[T, V]) Results(ids []g.ID) map[g.ID]*j.Result[V] {
+	_ret := _m.Called(ids)
+
+	if _rf, ok := _ret.Get(0).(func([]g.ID) map[g.ID]*j.Result[V]); ok {
+		return _rf(ids)
+	}
+
+	_ra0, _ := _ret.Get(0).(map[g.ID]*j.Result[V])
+
+	return _ra0
 }
 
-func (_c *coconutZooCall) OnMoo(fn func(Strawberry, Strawberry) Pineapple) *coconutMooCall {
-	return _c.Parent.OnMoo(fn)
+func (_m *feedMock[T, V]) OnResults(ids []g.ID) *feedResultsCall[T, V] {
+	return &feedResultsCall[T, V]{Call: _m.Mock.On("Results", ids), Parent: _m}
 }
 
-func (_c *coconutZooCall) OnNoo(ar [][2]string) *coconutNooCall {
-	return _c.Parent.OnNoo(ar)
+// OnResultsMatched is like OnResults but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *feedMock[T, V]) OnResultsMatched(ids func([]g.ID) bool) *feedResultsCall[T, V] {
+	_args := []interface{}{mock.Anything}
+
+	if ids != nil {
+		_args[0] = mock.MatchedBy(ids)
+	}
+
+	return &feedResultsCall[T, V]{Call: _m.Mock.On("Results", _args...), Parent: _m}
 }
 
-func (_c *coconutZooCall) OnPoo(str struct{ name string }) *coconutPooCall {
-	return _c.Parent.OnPoo(str)
+func (_m *feedMock[T, V]) OnResultsRaw(ids interface{}) *feedResultsCall[T, V] {
+	return &feedResultsCall[T, V]{Call: _m.Mock.On("Results", ids), Parent: _m}
 }
 
-func (_c *coconutZooCall) OnToo(src string) *coconutTooCall {
-	return _c.Parent.OnToo(src)
+type feedResultsCall[T any, V any] struct {
+	*mock.Call
+	Parent *feedMock[T, V]
 }
 
-func (_c *coconutZooCall) OnVoo(src *module.Version) *coconutVooCall {
-	return _c.Parent.OnVoo(src)
+func (_c *feedResultsCall[T, V]) Panic(msg string) *feedResultsCall[T, V] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
 }
 
-func (_c *coconutZooCall) OnYoo(st string) *coconutYooCall {
-	return _c.Parent.OnYoo(st)
+func (_c *feedResultsCall[T, V]) Once() *feedResultsCall[T, V] {
+	_c.Call = _c.Call.Once()
+	return _c
 }
 
-func (_c *coconutZooCall) OnZoo(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZoo(st)
+func (_c *feedResultsCall[T, V]) Twice() *feedResultsCall[T, V] {
+	_c.Call = _c.Call.Twice()
+	return _c
 }
 
-func (_c *coconutZooCall) OnBooRaw(src interface{}) *coconutBooCall {
-	return _c.Parent.OnBooRaw(src)
+func (_c *feedResultsCall[T, V]) Times(i int) *feedResultsCall[T, V] {
+	_c.Call = _c.Call.Times(i)
+	return _c
 }
 
-func (_c *coconutZooCall) OnDooRaw(src interface{}) *coconutDooCall {
-	return _c.Parent.OnDooRaw(src)
+func (_c *feedResultsCall[T, V]) WaitUntil(w <-chan time.Time) *feedResultsCall[T, V] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
 }
 
-func (_c *coconutZooCall) OnFooRaw(st interface{}) *coconutFooCall {
-	return _c.Parent.OnFooRaw(st)
+func (_c *feedResultsCall[T, V]) After(d time.Duration) *feedResultsCall[T, V] {
+	_c.Call = _c.Call.After(d)
+	return _c
 }
 
-func (_c *coconutZooCall) OnGooRaw(st interface{}) *coconutGooCall {
-	return _c.Parent.OnGooRaw(st)
+func (_c *feedResultsCall[T, V]) Run(fn func(args mock.Arguments)) *feedResultsCall[T, V] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
 }
 
-func (_c *coconutZooCall) OnHooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutHooCall {
-	return _c.Parent.OnHooRaw(aParam, bParam, cParam)
+func (_c *feedResultsCall[T, V]) Maybe() *feedResultsCall[T, V] {
+	_c.Call = _c.Call.Maybe()
+	return _c
 }
 
-func (_c *coconutZooCall) OnJooRaw(aParam interface{}, bParam interface{}, cParam interface{}) *coconutJooCall {
-	return _c.Parent.OnJooRaw(aParam, bParam, cParam)
+func (_c *feedResultsCall[T, V]) TypedReturns(a map[g.ID]*j.Result[V]) *feedResultsCall[T, V] {
+	_c.Call = _c.Return(a)
+	return _c
 }
 
-func (_c *coconutZooCall) OnKooRaw(src interface{}) *coconutKooCall {
-	return _c.Parent.OnKooRaw(src)
+func (_c *feedResultsCall[T, V]) ReturnsFn(fn func([]g.ID) map[g.ID]*j.Result[V]) *feedResultsCall[T, V] {
+	_c.Call = _c.Return(fn)
+	return _c
 }
 
-func (_c *coconutZooCall) OnLooRaw(st interface{}, values interface{}) *coconutLooCall {
-	return _c.Parent.OnLooRaw(st, values)
+func (_c *feedResultsCall[T, V]) TypedRun(fn func([]g.ID)) *feedResultsCall[T, V] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_ids, _ := args.Get(0).([]g.ID)
+		fn(_ids)
+	})
+	return _c
 }
 
-func (_c *coconutZooCall) OnMooRaw(fn interface{}) *coconutMooCall {
-	return _c.Parent.OnMooRaw(fn)
+func (_c *feedResultsCall[T, V]) OnEvents() *feedEventsCall[T, V] {
+	return _c.Parent.OnEvents()
 }
 
-func (_c *coconutZooCall) OnNooRaw(ar interface{}) *coconutNooCall {
-	return _c.Parent.OnNooRaw(ar)
+func (_c *feedResultsCall[T, V]) OnList() *feedListCall[T, V] {
+	return _c.Parent.OnList()
 }
 
-func (_c *coconutZooCall) OnPooRaw(str interface{}) *coconutPooCall {
-	return _c.Parent.OnPooRaw(str)
+func (_c *feedResultsCall[T, V]) OnResults(ids []g.ID) *feedResultsCall[T, V] {
+	return _c.Parent.OnResults(ids)
 }
 
-func (_c *coconutZooCall) OnTooRaw(src interface{}) *coconutTooCall {
-	return _c.Parent.OnTooRaw(src)
+func (_c *feedResultsCall[T, V]) OnResultsMatched(ids func([]g.ID) bool) *feedResultsCall[T, V] {
+	return _c.Parent.OnResultsMatched(ids)
 }
 
-func (_c *coconutZooCall) OnVooRaw(src interface{}) *coconutVooCall {
-	return _c.Parent.OnVooRaw(src)
+func (_c *feedResultsCall[T, V]) OnEventsRaw() *feedEventsCall[T, V] {
+	return _c.Parent.OnEventsRaw()
 }
 
-func (_c *coconutZooCall) OnYooRaw(st interface{}) *coconutYooCall {
-	return _c.Parent.OnYooRaw(st)
+func (_c *feedResultsCall[T, V]) OnListRaw() *feedListCall[T, V] {
+	return _c.Parent.OnListRaw()
 }
 
-func (_c *coconutZooCall) OnZooRaw(st interface{}) *coconutZooCall {
-	return _c.Parent.OnZooRaw(st)
+func (_c *feedResultsCall[T, V]) OnResultsRaw(ids interface{}) *feedResultsCall[T, V] {
+	return _c.Parent.OnResultsRaw(ids)
 }
 
-// carrotMock mock of Carrot.
-type carrotMock struct{ mock.Mock }
+// graterMock is a mock of the Grater interface (generated by mocktail).
+type graterMock struct{ mock.Mock }
 
-// newCarrotMock creates a new carrotMock.
-func newCarrotMock(tb testing.TB) *carrotMock {
+// newGraterMock creates a new graterMock.
+func newGraterMock(tb testing.TB) *graterMock {
 	tb.Helper()
 
-	m := &carrotMock{}
+	m := &graterMock{}
 	m.Mock.Test(tb)
 
 	tb.Cleanup(func() { m.AssertExpectations(tb) })
@@ -3708,212 +13941,230 @@ func newCarrotMock(tb testing.TB) *carrotMock {
 	return m
 }
 
-func (_m *carrotMock) Bar(aParam string) *b.Potato {
-	_ret := _m.Called(aParam)
+func (_m *graterMock) Close() error {
+	_ret := _m.Called()
 
-	if _rf, ok := _ret.Get(0).(func(string) *b.Potato); ok {
-		return _rf(aParam)
+	if _rf, ok := _ret.Get(0).(func() error); ok {
+		return _rf()
 	}
 
-	_ra0, _ := _ret.Get(0).(*b.Potato)
+	_ra0 := _ret.Error(0)
 
 	return _ra0
 }
 
-func (_m *carrotMock) OnBar(aParam string) *carrotBarCall {
-	return &carrotBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
+func (_m *graterMock) OnClose() *graterCloseCall {
+	return &graterCloseCall{Call: _m.Mock.On("Close"), Parent: _m}
 }
 
-func (_m *carrotMock) OnBarRaw(aParam interface{}) *carrotBarCall {
-	return &carrotBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
+func (_m *graterMock) OnCloseRaw() *graterCloseCall {
+	return &graterCloseCall{Call: _m.Mock.On("Close"), Parent: _m}
 }
 
-type carrotBarCall struct {
+type graterCloseCall struct {
 	*mock.Call
-	Parent *carrotMock
+	Parent *graterMock
 }
 
-func (_c *carrotBarCall) Panic(msg string) *carrotBarCall {
+func (_c *graterCloseCall) Panic(msg string) *graterCloseCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *carrotBarCall) Once() *carrotBarCall {
+func (_c *graterCloseCall) Once() *graterCloseCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *carrotBarCall) Twice() *carrotBarCall {
+func (_c *graterCloseCall) Twice() *graterCloseCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *carrotBarCall) Times(i int) *carrotBarCall {
+func (_c *graterCloseCall) Times(i int) *graterCloseCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *carrotBarCall) WaitUntil(w <-chan time.Time) *carrotBarCall {
+func (_c *graterCloseCall) WaitUntil(w <-chan time.Time) *graterCloseCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *carrotBarCall) After(d time.Duration) *carrotBarCall {
+func (_c *graterCloseCall) After(d time.Duration) *graterCloseCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *carrotBarCall) Run(fn func(args mock.Arguments)) *carrotBarCall {
+func (_c *graterCloseCall) Run(fn func(args mock.Arguments)) *graterCloseCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *carrotBarCall) Maybe() *carrotBarCall {
+func (_c *graterCloseCall) Maybe() *graterCloseCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *carrotBarCall) TypedReturns(a *b.Potato) *carrotBarCall {
+func (_c *graterCloseCall) TypedReturns(a error) *graterCloseCall {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *carrotBarCall) ReturnsFn(fn func(string) *b.Potato) *carrotBarCall {
+func (_c *graterCloseCall) ReturnsFn(fn func() error) *graterCloseCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *carrotBarCall) TypedRun(fn func(string)) *carrotBarCall {
+func (_c *graterCloseCall) TypedRun(fn func()) *graterCloseCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_aParam := args.String(0)
-		fn(_aParam)
+		fn()
 	})
 	return _c
 }
 
-func (_c *carrotBarCall) OnBar(aParam string) *carrotBarCall {
-	return _c.Parent.OnBar(aParam)
+func (_c *graterCloseCall) OnClose() *graterCloseCall {
+	return _c.Parent.OnClose()
 }
 
-func (_c *carrotBarCall) OnBur(aParam string) *carrotBurCall {
-	return _c.Parent.OnBur(aParam)
+func (_c *graterCloseCall) OnGrate(n int) *graterGrateCall {
+	return _c.Parent.OnGrate(n)
 }
 
-func (_c *carrotBarCall) OnBarRaw(aParam interface{}) *carrotBarCall {
-	return _c.Parent.OnBarRaw(aParam)
+func (_c *graterCloseCall) OnGrateMatched(n func(int) bool) *graterGrateCall {
+	return _c.Parent.OnGrateMatched(n)
+}
+
+func (_c *graterCloseCall) OnCloseRaw() *graterCloseCall {
+	return _c.Parent.OnCloseRaw()
 }
 
-func (_c *carrotBarCall) OnBurRaw(aParam interface{}) *carrotBurCall {
-	return _c.Parent.OnBurRaw(aParam)
+func (_c *graterCloseCall) OnGrateRaw(n interface{}) *graterGrateCall {
+	return _c.Parent.OnGrateRaw(n)
 }
 
-func (_m *carrotMock) Bur(aParam string) *c.Cherry {
-	_ret := _m.Called(aParam)
+func (_m *graterMock) Grate(n int) int {
+	_ret := _m.Called(n)
 
-	if _rf, ok := _ret.Get(0).(func(string) *c.Cherry); ok {
-		return _rf(aParam)
+	if _rf, ok := _ret.Get(0).(func(int) int); ok {
+		return _rf(n)
 	}
 
-	_ra0, _ := _ret.Get(0).(*c.Cherry)
+	_ra0 := _ret.Int(0)
 
 	return _ra0
 }
 
-func (_m *carrotMock) OnBur(aParam string) *carrotBurCall {
-	return &carrotBurCall{Call: _m.Mock.On("Bur", aParam), Parent: _m}
+func (_m *graterMock) OnGrate(n int) *graterGrateCall {
+	return &graterGrateCall{Call: _m.Mock.On("Grate", n), Parent: _m}
 }
 
-func (_m *carrotMock) OnBurRaw(aParam interface{}) *carrotBurCall {
-	return &carrotBurCall{Call: _m.Mock.On("Bur", aParam), Parent: _m}
+// OnGrateMatched is like OnGrate but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *graterMock) OnGrateMatched(n func(int) bool) *graterGrateCall {
+	_args := []interface{}{mock.Anything}
+
+	if n != nil {
+		_args[0] = mock.MatchedBy(n)
+	}
+
+	return &graterGrateCall{Call: _m.Mock.On("Grate", _args...), Parent: _m}
 }
 
-type carrotBurCall struct {
+func (_m *graterMock) OnGrateRaw(n interface{}) *graterGrateCall {
+	return &graterGrateCall{Call: _m.Mock.On("Grate", n), Parent: _m}
+}
+
+type graterGrateCall struct {
 	*mock.Call
-	Parent *carrotMock
+	Parent *graterMock
 }
 
-func (_c *carrotBurCall) Panic(msg string) *carrotBurCall {
+func (_c *graterGrateCall) Panic(msg string) *graterGrateCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *carrotBurCall) Once() *carrotBurCall {
+func (_c *graterGrateCall) Once() *graterGrateCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *carrotBurCall) Twice() *carrotBurCall {
+func (_c *graterGrateCall) Twice() *graterGrateCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *carrotBurCall) Times(i int) *carrotBurCall {
+func (_c *graterGrateCall) Times(i int) *graterGrateCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *carrotBurCall) WaitUntil(w <-chan time.Time) *carrotBurCall {
+func (_c *graterGrateCall) WaitUntil(w <-chan time.Time) *graterGrateCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *carrotBurCall) After(d time.Duration) *carrotBurCall {
+func (_c *graterGrateCall) After(d time.Duration) *graterGrateCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *carrotBurCall) Run(fn func(args mock.Arguments)) *carrotBurCall {
+func (_c *graterGrateCall) Run(fn func(args mock.Arguments)) *graterGrateCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *carrotBurCall) Maybe() *carrotBurCall {
+func (_c *graterGrateCall) Maybe() *graterGrateCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *carrotBurCall) TypedReturns(a *c.Cherry) *carrotBurCall {
+func (_c *graterGrateCall) TypedReturns(a int) *graterGrateCall {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *carrotBurCall) ReturnsFn(fn func(string) *c.Cherry) *carrotBurCall {
+func (_c *graterGrateCall) ReturnsFn(fn func(int) int) *graterGrateCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *carrotBurCall) TypedRun(fn func(string)) *carrotBurCall {
+func (_c *graterGrateCall) TypedRun(fn func(int)) *graterGrateCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_aParam := args.String(0)
-		fn(_aParam)
+		_n := args.Int(0)
+		fn(_n)
 	})
 	return _c
 }
 
-func (_c *carrotBurCall) OnBar(aParam string) *carrotBarCall {
-	return _c.Parent.OnBar(aParam)
+func (_c *graterGrateCall) OnClose() *graterCloseCall {
+	return _c.Parent.OnClose()
 }
 
-func (_c *carrotBurCall) OnBur(aParam string) *carrotBurCall {
-	return _c.Parent.OnBur(aParam)
+func (_c *graterGrateCall) OnGrate(n int) *graterGrateCall {
+	return _c.Parent.OnGrate(n)
 }
 
-func (_c *carrotBurCall) OnBarRaw(aParam interface{}) *carrotBarCall {
-	return _c.Parent.OnBarRaw(aParam)
+func (_c *graterGrateCall) OnGrateMatched(n func(int) bool) *graterGrateCall {
+	return _c.Parent.OnGrateMatched(n)
 }
 
-func (_c *carrotBurCall) OnBurRaw(aParam interface{}) *carrotBurCall {
-	return _c.Parent.OnBurRaw(aParam)
+func (_c *graterGrateCall) OnCloseRaw() *graterCloseCall {
+	return _c.Parent.OnCloseRaw()
 }
 
-// orangeMock mock of Orange.
-type orangeMock struct{ mock.Mock }
+func (_c *graterGrateCall) OnGrateRaw(n interface{}) *graterGrateCall {
+	return _c.Parent.OnGrateRaw(n)
+}
 
-// newOrangeMock creates a new orangeMock.
-func newOrangeMock(tb testing.TB) *orangeMock {
+// nodeMock is a mock of the Node interface (generated by mocktail).
+type nodeMock[T any] struct{ mock.Mock }
+
+// newNodeMock creates a new nodeMock.
+func newNodeMock[T any](tb testing.TB) *nodeMock[T] {
 	tb.Helper()
 
-	m := &orangeMock{}
+	m := &nodeMock[T]{}
 	m.Mock.Test(tb)
 
 	tb.Cleanup(func() { m.AssertExpectations(tb) })
@@ -3921,495 +14172,552 @@ func newOrangeMock(tb testing.TB) *orangeMock {
 	return m
 }
 
-func (_m *orangeMock) Juice() <-chan struct{} {
+func (_m *nodeMock[T]) Children() []Node[T] {
 	_ret := _m.Called()
 
-	if _rf, ok := _ret.Get(0).(func() <-chan struct{}); ok {
+	if _rf, ok := _ret.Get(0).(func() []Node[T]); ok {
 		return _rf()
 	}
 
-	_ra0, _ := _ret.Get(0).(<-chan struct{})
+	_ra0, _ := _ret.Get(0).([]Node[T])
 
 	return _ra0
 }
 
-func (_m *orangeMock) OnJuice() *orangeJuiceCall {
-	return &orangeJuiceCall{Call: _m.Mock.On("Juice"), Parent: _m}
+func (_m *nodeMock[T]) OnChildren() *nodeChildrenCall[T] {
+	return &nodeChildrenCall[T]{Call: _m.Mock.On("Children"), Parent: _m}
 }
 
-func (_m *orangeMock) OnJuiceRaw() *orangeJuiceCall {
-	return &orangeJuiceCall{Call: _m.Mock.On("Juice"), Parent: _m}
+func (_m *nodeMock[T]) OnChildrenRaw() *nodeChildrenCall[T] {
+	return &nodeChildrenCall[T]{Call: _m.Mock.On("Children"), Parent: _m}
 }
 
-type orangeJuiceCall struct {
+type nodeChildrenCall[T any] struct {
 	*mock.Call
-	Parent *orangeMock
+	Parent *nodeMock[T]
 }
 
-func (_c *orangeJuiceCall) Panic(msg string) *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) Panic(msg string) *nodeChildrenCall[T] {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *orangeJuiceCall) Once() *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) Once() *nodeChildrenCall[T] {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *orangeJuiceCall) Twice() *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) Twice() *nodeChildrenCall[T] {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *orangeJuiceCall) Times(i int) *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) Times(i int) *nodeChildrenCall[T] {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *orangeJuiceCall) WaitUntil(w <-chan time.Time) *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) WaitUntil(w <-chan time.Time) *nodeChildrenCall[T] {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *orangeJuiceCall) After(d time.Duration) *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) After(d time.Duration) *nodeChildrenCall[T] {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *orangeJuiceCall) Run(fn func(args mock.Arguments)) *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) Run(fn func(args mock.Arguments)) *nodeChildrenCall[T] {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *orangeJuiceCall) Maybe() *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) Maybe() *nodeChildrenCall[T] {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *orangeJuiceCall) TypedReturns(a <-chan struct{}) *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) TypedReturns(a []Node[T]) *nodeChildrenCall[T] {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *orangeJuiceCall) ReturnsFn(fn func() <-chan struct{}) *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) ReturnsFn(fn func() []Node[T]) *nodeChildrenCall[T] {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *orangeJuiceCall) TypedRun(fn func()) *orangeJuiceCall {
+func (_c *nodeChildrenCall[T]) TypedRun(fn func()) *nodeChildrenCall[T] {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
 		fn()
 	})
 	return _c
 }
 
-func (_c *orangeJuiceCall) OnJuice() *orangeJuiceCall {
-	return _c.Parent.OnJuice()
+func (_c *nodeChildrenCall[T]) OnChildren() *nodeChildrenCall[T] {
+	return _c.Parent.OnChildren()
 }
 
-func (_c *orangeJuiceCall) OnJuiceRaw() *orangeJuiceCall {
-	return _c.Parent.OnJuiceRaw()
+func (_c *nodeChildrenCall[T]) OnParent() *nodeParentCall[T] {
+	return _c.Parent.OnParent()
 }
 
-// cherryMock mock of Cherry.
-type cherryMock struct{ mock.Mock }
-
-// newCherryMock creates a new cherryMock.
-func newCherryMock(tb testing.TB) *cherryMock {
-	tb.Helper()
+func (_c *nodeChildrenCall[T]) OnValue() *nodeValueCall[T] {
+	return _c.Parent.OnValue()
+}
 
-	m := &cherryMock{}
-	m.Mock.Test(tb)
+func (_c *nodeChildrenCall[T]) OnChildrenRaw() *nodeChildrenCall[T] {
+	return _c.Parent.OnChildrenRaw()
+}
 
-	tb.Cleanup(func() { m.AssertExpectations(tb) })
+func (_c *nodeChildrenCall[T]) OnParentRaw() *nodeParentCall[T] {
+	return _c.Parent.OnParentRaw()
+}
 
-	return m
+func (_c *nodeChildrenCall[T]) OnValueRaw() *nodeValueCall[T] {
+	return _c.Parent.OnValueRaw()
 }
 
-func (_m *cherryMock) V2Carrot() e.V2Carrot {
+func (_m *nodeMock[T]) Parent() (Node[T], bool) {
 	_ret := _m.Called()
 
-	if _rf, ok := _ret.Get(0).(func() e.V2Carrot); ok {
+	if _rf, ok := _ret.Get(0).(func() (Node[T], bool)); ok {
 		return _rf()
 	}
 
-	_ra0, _ := _ret.Get(0).(e.V2Carrot)
+	_ra0, _ := _ret.Get(0).(Node[T])
+	_rb1 := _ret.Bool(1)
 
-	return _ra0
+	return _ra0, _rb1
 }
 
-func (_m *cherryMock) OnV2Carrot() *cherryV2CarrotCall {
-	return &cherryV2CarrotCall{Call: _m.Mock.On("V2Carrot"), Parent: _m}
+func (_m *nodeMock[T]) OnParent() *nodeParentCall[T] {
+	return &nodeParentCall[T]{Call: _m.Mock.On("Parent"), Parent: _m}
 }
 
-func (_m *cherryMock) OnV2CarrotRaw() *cherryV2CarrotCall {
-	return &cherryV2CarrotCall{Call: _m.Mock.On("V2Carrot"), Parent: _m}
+func (_m *nodeMock[T]) OnParentRaw() *nodeParentCall[T] {
+	return &nodeParentCall[T]{Call: _m.Mock.On("Parent"), Parent: _m}
 }
 
-type cherryV2CarrotCall struct {
+type nodeParentCall[T any] struct {
 	*mock.Call
-	Parent *cherryMock
+	Parent *nodeMock[T]
 }
 
-func (_c *cherryV2CarrotCall) Panic(msg string) *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) Panic(msg string) *nodeParentCall[T] {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) Once() *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) Once() *nodeParentCall[T] {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) Twice() *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) Twice() *nodeParentCall[T] {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) Times(i int) *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) Times(i int) *nodeParentCall[T] {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) WaitUntil(w <-chan time.Time) *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) WaitUntil(w <-chan time.Time) *nodeParentCall[T] {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) After(d time.Duration) *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) After(d time.Duration) *nodeParentCall[T] {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) Run(fn func(args mock.Arguments)) *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) Run(fn func(args mock.Arguments)) *nodeParentCall[T] {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) Maybe() *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) Maybe() *nodeParentCall[T] {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) TypedReturns(a e.V2Carrot) *cherryV2CarrotCall {
-	_c.Call = _c.Return(a)
+func (_c *nodeParentCall[T]) TypedReturns(a Node[T], d bool) *nodeParentCall[T] {
+	_c.Call = _c.Return(a, d)
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) ReturnsFn(fn func() e.V2Carrot) *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) ReturnsFn(fn func() (Node[T], bool)) *nodeParentCall[T] {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) TypedRun(fn func()) *cherryV2CarrotCall {
+func (_c *nodeParentCall[T]) TypedRun(fn func()) *nodeParentCall[T] {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
 		fn()
 	})
 	return _c
 }
 
-func (_c *cherryV2CarrotCall) OnV2Carrot() *cherryV2CarrotCall {
-	return _c.Parent.OnV2Carrot()
+func (_c *nodeParentCall[T]) OnChildren() *nodeChildrenCall[T] {
+	return _c.Parent.OnChildren()
 }
 
-func (_c *cherryV2CarrotCall) OnV2CarrotRaw() *cherryV2CarrotCall {
-	return _c.Parent.OnV2CarrotRaw()
+func (_c *nodeParentCall[T]) OnParent() *nodeParentCall[T] {
+	return _c.Parent.OnParent()
 }
 
-// bananaMock mock of Banana.
-type bananaMock[T any, U any] struct{ mock.Mock }
-
-// newBananaMock creates a new bananaMock.
-func newBananaMock[T any, U any](tb testing.TB) *bananaMock[T, U] {
-	tb.Helper()
+func (_c *nodeParentCall[T]) OnValue() *nodeValueCall[T] {
+	return _c.Parent.OnValue()
+}
 
-	m := &bananaMock[T, U]{}
-	m.Mock.Test(tb)
+func (_c *nodeParentCall[T]) OnChildrenRaw() *nodeChildrenCall[T] {
+	return _c.Parent.OnChildrenRaw()
+}
 
-	tb.Cleanup(func() { m.AssertExpectations(tb) })
+func (_c *nodeParentCall[T]) OnParentRaw() *nodeParentCall[T] {
+	return _c.Parent.OnParentRaw()
+}
 
-	return m
+func (_c *nodeParentCall[T]) OnValueRaw() *nodeValueCall[T] {
+	return _c.Parent.OnValueRaw()
 }
 
-func (_m *bananaMock[T, U]) Flower() U {
+func (_m *nodeMock[T]) Value() T {
 	_ret := _m.Called()
 
-	if _rf, ok := _ret.Get(0).(func() U); ok {
+	if _rf, ok := _ret.Get(0).(func() T); ok {
 		return _rf()
 	}
 
-	_ra0, _ := _ret.Get(0).(U)
+	_ra0, _ := _ret.Get(0).(T)
 
 	return _ra0
 }
 
-func (_m *bananaMock[T, U]) OnFlower() *bananaFlowerCall[T, U] {
-	return &bananaFlowerCall[T, U]{Call: _m.Mock.On("Flower"), Parent: _m}
+func (_m *nodeMock[T]) OnValue() *nodeValueCall[T] {
+	return &nodeValueCall[T]{Call: _m.Mock.On("Value"), Parent: _m}
 }
 
-func (_m *bananaMock[T, U]) OnFlowerRaw() *bananaFlowerCall[T, U] {
-	return &bananaFlowerCall[T, U]{Call: _m.Mock.On("Flower"), Parent: _m}
+func (_m *nodeMock[T]) OnValueRaw() *nodeValueCall[T] {
+	return &nodeValueCall[T]{Call: _m.Mock.On("Value"), Parent: _m}
 }
 
-type bananaFlowerCall[T any, U any] struct {
+type nodeValueCall[T any] struct {
 	*mock.Call
-	Parent *bananaMock[T, U]
+	Parent *nodeMock[T]
 }
 
-func (_c *bananaFlowerCall[T, U]) Panic(msg string) *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) Panic(msg string) *nodeValueCall[T] {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) Once() *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) Once() *nodeValueCall[T] {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) Twice() *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) Twice() *nodeValueCall[T] {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) Times(i int) *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) Times(i int) *nodeValueCall[T] {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) WaitUntil(w <-chan time.Time) *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) WaitUntil(w <-chan time.Time) *nodeValueCall[T] {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) After(d time.Duration) *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) After(d time.Duration) *nodeValueCall[T] {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) Run(fn func(args mock.Arguments)) *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) Run(fn func(args mock.Arguments)) *nodeValueCall[T] {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) Maybe() *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) Maybe() *nodeValueCall[T] {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) TypedReturns(a U) *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) TypedReturns(a T) *nodeValueCall[T] {
 	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) ReturnsFn(fn func() U) *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) ReturnsFn(fn func() T) *nodeValueCall[T] {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) TypedRun(fn func()) *bananaFlowerCall[T, U] {
+func (_c *nodeValueCall[T]) TypedRun(fn func()) *nodeValueCall[T] {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
 		fn()
 	})
 	return _c
 }
 
-func (_c *bananaFlowerCall[T, U]) OnFlower() *bananaFlowerCall[T, U] {
-	return _c.Parent.OnFlower()
+func (_c *nodeValueCall[T]) OnChildren() *nodeChildrenCall[T] {
+	return _c.Parent.OnChildren()
 }
 
-func (_c *bananaFlowerCall[T, U]) OnPudding() *bananaPuddingCall[T, U] {
-	return _c.Parent.OnPudding()
+func (_c *nodeValueCall[T]) OnParent() *nodeParentCall[T] {
+	return _c.Parent.OnParent()
 }
 
-func (_c *bananaFlowerCall[T, U]) OnTree(aParam T) *bananaTreeCall[T, U] {
-	return _c.Parent.OnTree(aParam)
+func (_c *nodeValueCall[T]) OnValue() *nodeValueCall[T] {
+	return _c.Parent.OnValue()
 }
 
-func (_c *bananaFlowerCall[T, U]) OnFlowerRaw() *bananaFlowerCall[T, U] {
-	return _c.Parent.OnFlowerRaw()
+func (_c *nodeValueCall[T]) OnChildrenRaw() *nodeChildrenCall[T] {
+	return _c.Parent.OnChildrenRaw()
 }
 
-func (_c *bananaFlowerCall[T, U]) OnPuddingRaw() *bananaPuddingCall[T, U] {
-	return _c.Parent.OnPuddingRaw()
+func (_c *nodeValueCall[T]) OnParentRaw() *nodeParentCall[T] {
+	return _c.Parent.OnParentRaw()
 }
 
-func (_c *bananaFlowerCall[T, U]) OnTreeRaw(aParam interface{}) *bananaTreeCall[T, U] {
-	return _c.Parent.OnTreeRaw(aParam)
+func (_c *nodeValueCall[T]) OnValueRaw() *nodeValueCall[T] {
+	return _c.Parent.OnValueRaw()
 }
 
-func (_m *bananaMock[T, U]) Pudding() {
-	_m.Called()
+// hasherMock is a mock of the Hasher interface (generated by mocktail).
+type hasherMock struct{ mock.Mock }
+
+// newHasherMock creates a new hasherMock.
+func newHasherMock(tb testing.TB) *hasherMock {
+	tb.Helper()
+
+	m := &hasherMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
 }
 
-func (_m *bananaMock[T, U]) OnPudding() *bananaPuddingCall[T, U] {
-	return &bananaPuddingCall[T, U]{Call: _m.Mock.On("Pudding"), Parent: _m}
+func (_m *hasherMock) Hash() *[32]byte {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() *[32]byte); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(*[32]byte)
+
+	return _ra0
 }
 
-func (_m *bananaMock[T, U]) OnPuddingRaw() *bananaPuddingCall[T, U] {
-	return &bananaPuddingCall[T, U]{Call: _m.Mock.On("Pudding"), Parent: _m}
+func (_m *hasherMock) OnHash() *hasherHashCall {
+	return &hasherHashCall{Call: _m.Mock.On("Hash"), Parent: _m}
 }
 
-type bananaPuddingCall[T any, U any] struct {
+func (_m *hasherMock) OnHashRaw() *hasherHashCall {
+	return &hasherHashCall{Call: _m.Mock.On("Hash"), Parent: _m}
+}
+
+type hasherHashCall struct {
 	*mock.Call
-	Parent *bananaMock[T, U]
+	Parent *hasherMock
 }
 
-func (_c *bananaPuddingCall[T, U]) Panic(msg string) *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) Panic(msg string) *hasherHashCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) Once() *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) Once() *hasherHashCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) Twice() *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) Twice() *hasherHashCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) Times(i int) *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) Times(i int) *hasherHashCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) WaitUntil(w <-chan time.Time) *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) WaitUntil(w <-chan time.Time) *hasherHashCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) After(d time.Duration) *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) After(d time.Duration) *hasherHashCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) Run(fn func(args mock.Arguments)) *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) Run(fn func(args mock.Arguments)) *hasherHashCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) Maybe() *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) Maybe() *hasherHashCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) TypedRun(fn func()) *bananaPuddingCall[T, U] {
+func (_c *hasherHashCall) TypedReturns(a *[32]byte) *hasherHashCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *hasherHashCall) ReturnsFn(fn func() *[32]byte) *hasherHashCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *hasherHashCall) TypedRun(fn func()) *hasherHashCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
 		fn()
 	})
 	return _c
 }
 
-func (_c *bananaPuddingCall[T, U]) OnFlower() *bananaFlowerCall[T, U] {
-	return _c.Parent.OnFlower()
+func (_c *hasherHashCall) OnHash() *hasherHashCall {
+	return _c.Parent.OnHash()
 }
 
-func (_c *bananaPuddingCall[T, U]) OnPudding() *bananaPuddingCall[T, U] {
-	return _c.Parent.OnPudding()
+func (_c *hasherHashCall) OnProcess(entries *[4]record.Entry) *hasherProcessCall {
+	return _c.Parent.OnProcess(entries)
 }
 
-func (_c *bananaPuddingCall[T, U]) OnTree(aParam T) *bananaTreeCall[T, U] {
-	return _c.Parent.OnTree(aParam)
+func (_c *hasherHashCall) OnProcessMatched(entries func(*[4]record.Entry) bool) *hasherProcessCall {
+	return _c.Parent.OnProcessMatched(entries)
 }
 
-func (_c *bananaPuddingCall[T, U]) OnFlowerRaw() *bananaFlowerCall[T, U] {
-	return _c.Parent.OnFlowerRaw()
+func (_c *hasherHashCall) OnHashRaw() *hasherHashCall {
+	return _c.Parent.OnHashRaw()
 }
 
-func (_c *bananaPuddingCall[T, U]) OnPuddingRaw() *bananaPuddingCall[T, U] {
-	return _c.Parent.OnPuddingRaw()
+func (_c *hasherHashCall) OnProcessRaw(entries interface{}) *hasherProcessCall {
+	return _c.Parent.OnProcessRaw(entries)
 }
 
-func (_c *bananaPuddingCall[T, U]) OnTreeRaw(aParam interface{}) *bananaTreeCall[T, U] {
-	return _c.Parent.OnTreeRaw(aParam)
+func (_m *hasherMock) Process(entries *[4]record.Entry) error {
+	_ret := _m.Called(entries)
+
+	if _rf, ok := _ret.Get(0).(func(*[4]record.Entry) error); ok {
+		return _rf(entries)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
 }
 
-func (_m *bananaMock[T, U]) Tree(aParam T) {
-	_m.Called(aParam)
+func (_m *hasherMock) OnProcess(entries *[4]record.Entry) *hasherProcessCall {
+	return &hasherProcessCall{Call: _m.Mock.On("Process", entries), Parent: _m}
 }
 
-func (_m *bananaMock[T, U]) OnTree(aParam T) *bananaTreeCall[T, U] {
-	return &bananaTreeCall[T, U]{Call: _m.Mock.On("Tree", aParam), Parent: _m}
+// OnProcessMatched is like OnProcess but uses a typed matcher for each argument, a nil matcher matches any value.
+func (_m *hasherMock) OnProcessMatched(entries func(*[4]record.Entry) bool) *hasherProcessCall {
+	_args := []interface{}{mock.Anything}
+
+	if entries != nil {
+		_args[0] = mock.MatchedBy(entries)
+	}
+
+	return &hasherProcessCall{Call: _m.Mock.On("Process", _args...), Parent: _m}
 }
 
-func (_m *bananaMock[T, U]) OnTreeRaw(aParam interface{}) *bananaTreeCall[T, U] {
-	return &bananaTreeCall[T, U]{Call: _m.Mock.On("Tree", aParam), Parent: _m}
+func (_m *hasherMock) OnProcessRaw(entries interface{}) *hasherProcessCall {
+	return &hasherProcessCall{Call: _m.Mock.On("Process", entries), Parent: _m}
 }
 
-type bananaTreeCall[T any, U any] struct {
+type hasherProcessCall struct {
 	*mock.Call
-	Parent *bananaMock[T, U]
+	Parent *hasherMock
 }
 
-func (_c *bananaTreeCall[T, U]) Panic(msg string) *bananaTreeCall[T, U] {
+func (_c *hasherProcessCall) Panic(msg string) *hasherProcessCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) Once() *bananaTreeCall[T, U] {
+func (_c *hasherProcessCall) Once() *hasherProcessCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) Twice() *bananaTreeCall[T, U] {
+func (_c *hasherProcessCall) Twice() *hasherProcessCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) Times(i int) *bananaTreeCall[T, U] {
+func (_c *hasherProcessCall) Times(i int) *hasherProcessCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) WaitUntil(w <-chan time.Time) *bananaTreeCall[T, U] {
+func (_c *hasherProcessCall) WaitUntil(w <-chan time.Time) *hasherProcessCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) After(d time.Duration) *bananaTreeCall[T, U] {
+func (_c *hasherProcessCall) After(d time.Duration) *hasherProcessCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) Run(fn func(args mock.Arguments)) *bananaTreeCall[T, U] {
+func (_c *hasherProcessCall) Run(fn func(args mock.Arguments)) *hasherProcessCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) Maybe() *bananaTreeCall[T, U] {
+func (_c *hasherProcessCall) Maybe() *hasherProcessCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) TypedRun(fn func(T)) *bananaTreeCall[T, U] {
-	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_aParam, _ := args.Get(0).(T)
-		fn(_aParam)
-	})
+func (_c *hasherProcessCall) TypedReturns(a error) *hasherProcessCall {
+	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) OnFlower() *bananaFlowerCall[T, U] {
-	return _c.Parent.OnFlower()
+func (_c *hasherProcessCall) ReturnsFn(fn func(*[4]record.Entry) error) *hasherProcessCall {
+	_c.Call = _c.Return(fn)
+	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) OnPudding() *bananaPuddingCall[T, U] {
-	return _c.Parent.OnPudding()
+func (_c *hasherProcessCall) TypedRun(fn func(*[4]record.Entry)) *hasherProcessCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_entries, _ := args.Get(0).(*[4]record.Entry)
+		fn(_entries)
+	})
+	return _c
 }
 
-func (_c *bananaTreeCall[T, U]) OnTree(aParam T) *bananaTreeCall[T, U] {
-	return _c.Parent.OnTree(aParam)
+func (_c *hasherProcessCall) OnHash() *hasherHashCall {
+	return _c.Parent.OnHash()
 }
 
-func (_c *bananaTreeCall[T, U]) OnFlowerRaw() *bananaFlowerCall[T, U] {
-	return _c.Parent.OnFlowerRaw()
+func (_c *hasherProcessCall) OnProcess(entries *[4]record.Entry) *hasherProcessCall {
+	return _c.Parent.OnProcess(entries)
 }
 
-func (_c *bananaTreeCall[T, U]) OnPuddingRaw() *bananaPuddingCall[T, U] {
-	return _c.Parent.OnPuddingRaw()
+func (_c *hasherProcessCall) OnProcessMatched(entries func(*[4]record.Entry) bool) *hasherProcessCall {
+	return _c.Parent.OnProcessMatched(entries)
 }
 
-func (_c *bananaTreeCall[T, U]) OnTreeRaw(aParam interface{}) *bananaTreeCall[T, U] {
-	return _c.Parent.OnTreeRaw(aParam)
+func (_c *hasherProcessCall) OnHashRaw() *hasherHashCall {
+	return _c.Parent.OnHashRaw()
+}
+
+func (_c *hasherProcessCall) OnProcessRaw(entries interface{}) *hasherProcessCall {
+	return _c.Parent.OnProcessRaw(entries)
 }
@@ -2,17 +2,47 @@ package a
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
+	"unsafe"
+
+	"a/b"
+	fsql "a/f/sql"
+	"a/g"
+	"a/j"
+	"a/record"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/mod/module"
 )
 
 // mocktail:Pineapple
 // mocktail:Coconut
 // mocktail:b.Carrot
 // mocktail-:fmt.Stringer
-// mocktail:Orange
-// mocktail:d.Cherry
+// mocktail: Orange, d.Cherry
 // mocktail:Banana
+// mocktail:Cache
+// mocktail:Kitchen
+// mocktail:Database
+// mocktail:Library
+// mocktail:Applier
+// mocktail:Number
+// mocktail:Lemon
+// mocktail:Ordered
+// mocktail:Logger
+// mocktail:Feed
+// mocktail:Grater
+// mocktail:Node
+// mocktail:Hasher
+
+// The methods of the mocks have pointer receivers, like the methods of grater:
+// the pointers to the mocks implement the interfaces, the mocks themselves don't.
+var (
+	_ Grater  = (*grater)(nil)
+	_ Grater  = (*graterMock)(nil)
+	_ Kitchen = (*kitchenMock)(nil)
+)
 
 func TestName(t *testing.T) {
 	var s Pineapple = newPineappleMock(t).
@@ -21,6 +51,8 @@ func TestName(t *testing.T) {
 		OnGoo().TypedReturns("", 1, Water{}).Once().
 		OnCoo("", Water{}).TypedReturns(Water{}).
 		TypedRun(func(string,  Water) {}).Once().
+		OnNoo().TypedReturns("noo").Once().
+		OnPing().TypedReturns(errors.New("unreachable")).Once().
 		Parent
 
 	s.Hello(Water{})
@@ -28,6 +60,14 @@ func TestName(t *testing.T) {
 	s.Goo()
 	s.Coo(context.Background(), "", Water{})
 
+	if noo := s.Noo(context.Background()); noo != "noo" {
+		t.Fatalf("unexpected noo: %s", noo)
+	}
+
+	if err := s.Ping(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+
 	fn := func( Strawberry,  Strawberry) Pineapple {
 		return s
 	}
@@ -37,12 +77,50 @@ func TestName(t *testing.T) {
 		OnMoo(fn).TypedReturns("").Once().
 		OnNoo([][2]string{{"a", "b"}}).TypedReturns("").
 		OnPoo(struct{ name string }{name: "poo"}).TypedReturns("").Once().
+		OnQoo("a", 1).TypedReturns("b", 2, true, nil, Water{}, []byte("c")).Once().
+		OnRoo([]error{errors.New("a")}).TypedReturns([]error{errors.New("b")}).Once().
+		OnSoo(map[string]error{"a": nil}).TypedReturns(map[string]error{"b": nil}, nil).Once().
+		OnSplit("a,b").TypedReturns("a", "b").Once().
+		OnPair().TypedReturns("a", "b").Once().
+		OnRet().TypedReturns("a", "b").Once().
+		OnWoo(func(string, ...int) string { return "" }).TypedReturns("a").Once().
+		OnKooMatched(func(src string) bool { return src == "k" }).TypedReturns("kk").Once().
+		OnJooMatched(nil, func(i int) bool { return i > 0 }, nil).TypedReturns("j", 1).Once().
 		Parent
 
 	c.Loo("a", 1, 2)
 	c.Moo(fn)
 	c.Noo([][2]string{{"a", "b"}})
 	c.Poo(struct{ name string }{name: "poo"})
+	c.Qoo("a", 1)
+
+	if errs := c.Roo([]error{errors.New("a")}); len(errs) != 1 || errs[0].Error() != "b" {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	if errs, err := c.Soo(map[string]error{"a": nil}); err != nil || len(errs) != 1 {
+		t.Fatalf("unexpected errors: %v, %v", errs, err)
+	}
+
+	if left, right := c.Split("a,b"); left != "a" || right != "b" {
+		t.Fatalf("unexpected split: %s, %s", left, right)
+	}
+
+	if first, second := c.Pair(); first != "a" || second != "b" {
+		t.Fatalf("unexpected pair: %s, %s", first, second)
+	}
+
+	if first, second := c.Ret(); first != "a" || second != "b" {
+		t.Fatalf("unexpected ret: %s, %s", first, second)
+	}
+
+	c.Woo(func(prefix string, values ...int) string { return prefix })
+
+	if dst := c.Koo("k"); dst != "kk" {
+		t.Fatalf("unexpected koo: %s", dst)
+	}
+
+	c.Joo("a", 2, Water{})
 
 	juiceCh := make(chan struct{}, 1)
 	juiceCh <- struct{}{}
@@ -66,4 +144,327 @@ func TestName(t *testing.T) {
 	b.Tree("a")
 	b.Flower()
 	b.Pudding()
+
+	var ca Cache[string, int] = newCacheMock[string, int](t).
+		OnGet("a").TypedReturns(1, true).Once().
+		OnGet("b").TypedReturns(0, false).Once().
+		OnSet("c", 3).Once().
+		Parent
+
+	if v, ok := ca.Get("a"); !ok || v != 1 {
+		t.Fatalf("unexpected cache value: %d, %t", v, ok)
+	}
+
+	if _, ok := ca.Get("b"); ok {
+		t.Fatal("unexpected cache hit")
+	}
+
+	ca.Set("c", 3)
+
+	var k Kitchen = newKitchenMock(t).
+		OnBar("a").TypedReturns(nil).After(10 * time.Millisecond).Once().
+		OnCook(nil).TypedReturns(nil).Once().
+		Parent
+
+	start := time.Now()
+
+	if p := k.Bar("a"); p != nil {
+		t.Fatalf("unexpected potato: %v", p)
+	}
+
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Fatalf("the call returned too early: %s", elapsed)
+	}
+
+	if err := k.Cook(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var db Database = newDatabaseMock(t).
+		OnExec("q").TypedReturnsError(errors.New("closed")).Once().
+		OnLast().TypedReturns(fsql.Result{Rows: 1}).Once().
+		Parent
+
+	if _, err := db.Exec("q"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if r := db.Last(); r.Rows != 1 {
+		t.Fatalf("unexpected rows: %d", r.Rows)
+	}
+
+	var applied []Change
+
+	var ap Applier = newApplierMock(t).
+		OnApply([]Change{{Path: "a"}}).
+		TypedRun(func(changes []Change, _ ...Option) { applied = changes }).
+		Once().
+		OnApplyRaw(mock.Anything, mock.Anything).Maybe().
+		Parent
+
+	ap.Apply([]Change{{Path: "a"}})
+
+	if len(applied) != 1 || applied[0].Path != "a" {
+		t.Fatalf("unexpected changes: %v", applied)
+	}
+
+	page := &Page[g.User]{Items: []g.User{{Name: "bob"}}}
+
+	var lib Library = newLibraryMock(t).
+		OnGet(page).TypedReturns(page).Once().
+		OnList([]Page[g.User]{*page}).TypedReturns([]Page[g.User]{*page}).Once().
+		OnIndex(map[string]*Page[g.User]{"a": page}).TypedReturns(map[string]*Page[g.User]{"a": page}).Once().
+		Parent
+
+	if p := lib.Get(page); p.Items[0].Name != "bob" {
+		t.Fatalf("unexpected page: %v", p)
+	}
+
+	if pages := lib.List([]Page[g.User]{*page}); len(pages) != 1 {
+		t.Fatalf("unexpected pages: %v", pages)
+	}
+
+	if pages := lib.Index(map[string]*Page[g.User]{"a": page}); pages["a"] != page {
+		t.Fatalf("unexpected pages: %v", pages)
+	}
+
+	feedEvents := make(chan *j.Event[string], 1)
+	feedEvents <- &j.Event[string]{Payload: "a"}
+
+	var feed Feed[string, int] = newFeedMock[string, int](t).
+		OnList().TypedReturns([]*j.Page[g.User]{{Items: page.Items}}).Once().
+		OnResults([]g.ID{"a"}).TypedReturns(map[g.ID]*j.Result[int]{"a": {Value: 1}}).Once().
+		OnEvents().TypedReturns(feedEvents).Once().
+		Parent
+
+	if pages := feed.List(); len(pages) != 1 || pages[0].Items[0].Name != "bob" {
+		t.Fatalf("unexpected pages: %v", pages)
+	}
+
+	if results := feed.Results([]g.ID{"a"}); results["a"].Value != 1 {
+		t.Fatalf("unexpected results: %v", results)
+	}
+
+	if e := <-feed.Events(); e.Payload != "a" {
+		t.Fatalf("unexpected event: %v", e)
+	}
+
+	seeds := make(chan module.Version, 2)
+
+	var l Lemon = newLemonMock(t).
+		OnSqueeze(2).TypedReturns("juice").Once().
+		OnSeeds().TypedReturns(seeds).Once().
+		Parent
+
+	if juice := l.Squeeze(2); juice != "juice" {
+		t.Fatalf("unexpected juice: %s", juice)
+	}
+
+	events := l.Seeds()
+
+	seeds <- module.Version{Path: "a", Version: "v1.0.0"}
+	seeds <- module.Version{Path: "b", Version: "v2.0.0"}
+	close(seeds)
+
+	var paths []string
+	for v := range events {
+		paths = append(paths, v.Path)
+	}
+
+	if len(paths) != 2 || paths[0] != "a" || paths[1] != "b" {
+		t.Fatalf("unexpected seeds: %v", paths)
+	}
+
+	picked := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var pl Lemon = newLemonMock(t).
+		OnPick(picked, module.Version{Path: "a"}).TypedReturns(nil).Once().
+		Parent
+
+	if r := pl.Pick(picked, module.Version{Path: "a"}); r != nil {
+		t.Fatalf("unexpected reader: %v", r)
+	}
+
+	var zl Lemon = newLemonMock(t).
+		OnZest().TypedReturns(3, nil).Once().
+		Parent
+
+	if n, err := zl.Zest(); n != 3 || err != nil {
+		t.Fatalf("unexpected zest: %d, %v", n, err)
+	}
+
+	var jl Lemon = newLemonMock(t).
+		OnJuice().TypedReturns("orange").Once().
+		OnPulp().TypedReturns(map[string]any{"bits": 2}).Once().
+		Parent
+
+	if j := jl.Juice(); j != "orange" {
+		t.Fatalf("unexpected juice: %v", j)
+	}
+
+	if p := jl.Pulp(); p["bits"] != 2 {
+		t.Fatalf("unexpected pulp: %v", p)
+	}
+
+	var pit int
+
+	var al Lemon = newLemonMock(t).
+		OnAddress().TypedReturns(unsafe.Pointer(&pit)).Once().
+		Parent
+
+	if p := al.Address(); p != unsafe.Pointer(&pit) {
+		t.Fatalf("unexpected address: %v", p)
+	}
+
+	// The maps of functions are not comparable: they are matched with a matcher.
+	var hl Lemon = newLemonMock(t).
+		OnPourMatched(func(handlers map[string]func(context.Context) error) bool { return len(handlers) == 1 }).TypedReturns(nil).Once().
+		Parent
+
+	if err := hl.Pour(map[string]func(context.Context) error{"drain": func(context.Context) error { return nil }}); err != nil {
+		t.Fatal(err)
+	}
+
+	var pr Lemon = newLemonMock(t).
+		OnPress().TypedReturns(nil).Once().
+		Parent
+
+	if r := pr.Press(); r != nil {
+		t.Fatalf("unexpected press: %v", r)
+	}
+
+	var orange Orange = newOrangeMock(t)
+
+	var rl Lemon = newLemonMock(t).
+		OnRegister(&orange, nil).TypedReturns(nil).Once().
+		Parent
+
+	if err := rl.Register(&orange, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var ord Ordered[age] = newOrderedMock[age](t).
+		OnLess(age(2)).TypedReturns(true).Once().
+		Parent
+
+	if !ord.Less(age(2)) {
+		t.Fatal("unexpected order")
+	}
+}
+
+type age int
+
+func (a age) Less(other age) bool { return a < other }
+
+func TestGrater(t *testing.T) {
+	var g Grater = newGraterMock(t).
+		OnGrate(2).TypedReturns(2).Once().
+		OnClose().TypedReturns(nil).Once().
+		Parent
+
+	if n := g.Grate(2); n != 2 {
+		t.Fatalf("unexpected grated: %d", n)
+	}
+
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHasher(t *testing.T) {
+	sum := [32]byte{1}
+	entries := [4]record.Entry{{Key: "a"}}
+
+	var h Hasher = newHasherMock(t).
+		OnHash().TypedReturns(&sum).Once().
+		OnProcess(&entries).TypedReturns(nil).Once().
+		Parent
+
+	if got := h.Hash(); got[0] != 1 {
+		t.Fatalf("unexpected hash: %v", got)
+	}
+
+	if err := h.Process(&entries); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNode(t *testing.T) {
+	leaf := newNodeMock[int](t).
+		OnValue().TypedReturns(2).Once().
+		Parent
+
+	var root Node[int] = newNodeMock[int](t).
+		OnChildren().TypedReturns([]Node[int]{leaf}).Once().
+		OnParent().TypedReturns(nil, false).Once().
+		Parent
+
+	children := root.Children()
+	if len(children) != 1 || children[0].Value() != 2 {
+		t.Fatalf("unexpected children: %v", children)
+	}
+
+	if _, ok := root.Parent(); ok {
+		t.Fatal("unexpected parent")
+	}
+}
+
+func TestKitchen_garnish(t *testing.T) {
+	// b.Cherry is an alias of c.Cherry, kept as written in the mock.
+	var k Kitchen = newKitchenMock(t).
+		OnGarnish(b.Cherry{Name: "a"}).TypedReturns(nil).Once().
+		Parent
+
+	if err := k.Garnish(b.Cherry{Name: "a"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPineapple_rawPassthrough(t *testing.T) {
+	m := newPineappleMock(t)
+
+	// The untyped testify API is available on the calls returned by the Raw methods.
+	m.OnHelloRaw(mock.Anything).Return("a").Once()
+	m.OnWorldRaw().Call.Return("b").Once()
+
+	var p Pineapple = m
+
+	if got := p.Hello(Water{}); got != "a" {
+		t.Fatalf("unexpected value: %s", got)
+	}
+
+	if got := p.World(); got != "b" {
+		t.Fatalf("unexpected value: %s", got)
+	}
+}
+
+func TestLogger(t *testing.T) {
+	var logged []any
+	var formats []string
+
+	logf := func(format string, args ...interface{}) {
+		formats = append(formats, format)
+		logged = append(logged, args...)
+	}
+
+	var l Logger = newLoggerMock(t).
+		OnLog("a", 1).TypedRun(func(args ...any) { logged = append(logged, args...) }).Once().
+		OnLog().Once().
+		OnLogf("%s=%d", "b", 2).TypedRun(logf).TypedReturns(3).Once().
+		Parent
+
+	l.Log("a", 1)
+	l.Log()
+
+	if n := l.Logf("%s=%d", "b", 2); n != 3 {
+		t.Fatalf("unexpected length: %d", n)
+	}
+
+	if len(logged) != 4 || logged[0] != "a" || logged[1] != 1 || logged[2] != "b" || logged[3] != 2 {
+		t.Fatalf("unexpected arguments: %v", logged)
+	}
+
+	if len(formats) != 1 || formats[0] != "%s=%d" {
+		t.Fatalf("unexpected formats: %v", formats)
+	}
 }
@@ -0,0 +1,5 @@
+package record
+
+type Entry struct {
+	Key string
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"golang.org/x/mod/modfile"
+)
+
+// readWorkspaceModules reads the modules used by a go.work file, in the order of the use directives.
+func readWorkspaceModules(goWorkPath string) ([]modInfo, error) {
+	data, err := os.ReadFile(goWorkPath)
+	if err != nil {
+		return nil, err
+	}
+
+	goWorkFile, err := modfile.ParseWork(goWorkPath, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []modInfo
+
+	for _, use := range goWorkFile.Use {
+		dir := filepath.FromSlash(use.Path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(goWorkPath), dir)
+		}
+
+		info, err := readModuleInfo(filepath.Join(dir, "go.mod"))
+		if err != nil {
+			return nil, fmt.Errorf("workspace module %q: %w", use.Path, err)
+		}
+
+		modules = append(modules, info)
+	}
+
+	return modules, nil
+}
+
+// walkWorkspace builds the model of the modules of a go.work workspace:
+// each module is walked from its own directory, the import paths are relative to its own module path.
+func walkWorkspace(ctx context.Context, modules []modInfo, opts Options) (map[string]PackageDesc, error) {
+	model := make(map[string]PackageDesc)
+
+	// The errors of the packages, with the keep-going option.
+	var errs []error
+
+	for _, module := range walkedModules(modules) {
+		pkgs, err := walk(ctx, module.Dir, module.Path, opts)
+		if err != nil {
+			if !opts.KeepGoing || pkgs == nil {
+				return nil, fmt.Errorf("module %q: %w", module.Path, err)
+			}
+
+			errs = append(errs, err)
+		}
+
+		maps.Copy(model, pkgs)
+	}
+
+	return model, errors.Join(errs...)
+}
+
+// walkedModules returns the modules of the workspace walked from their own directory:
+// the modules nested in another module of the workspace are walked with their parent module.
+func walkedModules(modules []modInfo) []modInfo {
+	byDir := map[string]modInfo{}
+	for _, module := range modules {
+		byDir[module.Dir] = module
+	}
+
+	var walked []modInfo
+
+	for _, module := range modules {
+		if _, ok := findModule(byDir, filepath.Dir(module.Dir)); ok {
+			continue
+		}
+
+		walked = append(walked, module)
+	}
+
+	return walked
+}
+
+// generateWorkspace generates the mocks of the packages of a workspace, module by module:
+// the output files and the import paths of the mocks are relative to the module of each package.
+func generateWorkspace(model map[string]PackageDesc, modules []modInfo, opts Options, tmpl *template.Template, summary *Summary) error {
+	byDir := map[string]modInfo{}
+	for _, module := range modules {
+		byDir[module.Dir] = module
+	}
+
+	models := map[string]map[string]PackageDesc{}
+
+	for fp, pkgDesc := range model {
+		module, _ := findModule(byDir, filepath.Dir(fp))
+
+		if models[module.Dir] == nil {
+			models[module.Dir] = map[string]PackageDesc{}
+		}
+
+		models[module.Dir][fp] = pkgDesc
+	}
+
+	// The errors of the packages, with the keep-going option.
+	var errs []error
+
+	for _, module := range modules {
+		pkgs, ok := models[module.Dir]
+		if !ok {
+			continue
+		}
+
+		err := generate(pkgs, module.Dir, module.Path, opts, tmpl, summary)
+		if err != nil {
+			if !opts.KeepGoing {
+				return fmt.Errorf("module %q: %w", module.Path, err)
+			}
+
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
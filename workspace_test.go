@@ -0,0 +1,141 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeWorkspace(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+
+	files := map[string]string{
+		"go.work":                     "go 1.24\n\nuse (\n\t./apple\n\t./banana\n\t./banana/split\n)\n",
+		"apple/go.mod":                "module example.com/apple\n\ngo 1.24\n",
+		"apple/b/b.go":                "package b\n\ntype Carrot interface {\n\tPeel() error\n}\n",
+		"apple/b/mock_test.go":        "package b\n\n// mocktail:Carrot\n",
+		"banana/go.mod":               "module example.org/banana\n\ngo 1.24\n",
+		"banana/c/c.go":               "package c\n\ntype Coconut interface {\n\tCrack() error\n}\n",
+		"banana/c/mock_test.go":       "package c\n\n// mocktail:Coconut\n",
+		"banana/split/go.mod":         "module example.net/split\n\ngo 1.24\n",
+		"banana/split/d/d.go":         "package d\n\ntype Durian interface {\n\tSmell() error\n}\n",
+		"banana/split/d/mock_test.go": "package d\n\n// mocktail:Durian\n",
+	}
+
+	writeTree(t, root, files)
+
+	return root
+}
+
+func Test_getModuleInfo_workspace(t *testing.T) {
+	root := writeWorkspace(t)
+
+	info, err := getModuleInfo(t.Context(), root)
+	require.NoError(t, err)
+
+	assert.Equal(t, modInfo{Dir: root, GoWork: filepath.Join(root, "go.work")}, info)
+
+	info, err = getModuleInfo(t.Context(), filepath.Join(root, "banana"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.org/banana", info.Path)
+	assert.Empty(t, info.GoWork)
+}
+
+func Test_readWorkspaceModules(t *testing.T) {
+	root := writeWorkspace(t)
+
+	modules, err := readWorkspaceModules(filepath.Join(root, "go.work"))
+	require.NoError(t, err)
+
+	var paths []string
+	for _, module := range modules {
+		paths = append(paths, module.Path)
+	}
+
+	assert.Equal(t, []string{"example.com/apple", "example.org/banana", "example.net/split"}, paths)
+	assert.Equal(t, filepath.Join(root, "banana", "split"), modules[2].Dir)
+}
+
+func Test_walkWorkspace(t *testing.T) {
+	// The workspace mode rejects the -mod=mod flag.
+	t.Setenv("GOFLAGS", "-mod=readonly")
+
+	root := writeWorkspace(t)
+
+	modules, err := readWorkspaceModules(filepath.Join(root, "go.work"))
+	require.NoError(t, err)
+
+	model, err := walkWorkspace(t.Context(), modules, Options{})
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		filepath.Join(root, "apple", "b", srcMockFile):           "example.com/apple/b",
+		filepath.Join(root, "banana", "c", srcMockFile):          "example.org/banana/c",
+		filepath.Join(root, "banana", "split", "d", srcMockFile): "example.net/split/d",
+	}
+
+	paths := map[string]string{}
+	for fp, pkgDesc := range model {
+		paths[fp] = pkgDesc.Pkg.Path()
+	}
+
+	assert.Equal(t, expected, paths)
+}
+
+func Test_walkedModules(t *testing.T) {
+	modules := []modInfo{
+		{Path: "example.com/a", Dir: filepath.Join("work", "a")},
+		{Path: "example.com/a/b", Dir: filepath.Join("work", "a", "b")},
+		{Path: "example.com/c", Dir: filepath.Join("work", "c")},
+	}
+
+	assert.Equal(t, []modInfo{modules[0], modules[2]}, walkedModules(modules))
+}
+
+func Test_generateWorkspace_mocksSubPkg(t *testing.T) {
+	// The workspace mode rejects the -mod=mod flag.
+	t.Setenv("GOFLAGS", "-mod=readonly")
+
+	root := writeWorkspace(t)
+
+	// The mocks subpackage of an internal package can only import it from the same module.
+	files := map[string]string{
+		"banana/split/internal/e/e.go":         "package e\n\ntype Scent string\n\ntype Elderberry interface {\n\tSmell() Scent\n}\n",
+		"banana/split/internal/e/mock_test.go": "package e\n\n// mocktail:Elderberry\n",
+	}
+
+	writeTree(t, root, files)
+
+	modules, err := readWorkspaceModules(filepath.Join(root, "go.work"))
+	require.NoError(t, err)
+
+	opts := Options{Exported: true, MocksSubPkg: true}
+
+	model, err := walkWorkspace(t.Context(), modules, opts)
+	require.NoError(t, err)
+
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	var summary Summary
+
+	err = generateWorkspace(model, modules, opts, tmpl, &summary)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(root, "banana", "split", "internal", "e", mocksSubPackage, outputExportedMockFile))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), "package mocks")
+	assert.Contains(t, string(content), `"example.net/split/internal/e"`)
+	assert.Contains(t, string(content), "func (_m *elderberryMock) Smell() e.Scent {")
+
+	assert.FileExists(t, filepath.Join(root, "apple", "b", mocksSubPackage, outputExportedMockFile))
+	assert.FileExists(t, filepath.Join(root, "banana", "c", mocksSubPackage, outputExportedMockFile))
+	assert.FileExists(t, filepath.Join(root, "banana", "split", "d", mocksSubPackage, outputExportedMockFile))
+}